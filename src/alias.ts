@@ -0,0 +1,19 @@
+import { Optional, stripIndent } from "./common.js";
+import { PropertyType, renderType } from "./model.js";
+import { BaseSymbol } from "./symbol.js";
+
+export class AliasSymbol implements BaseSymbol {
+  public readonly kind: "alias" = "alias";
+
+  public constructor(
+    public name: string,
+    public namespace: Optional<string>,
+    public goName: string,
+    public target: PropertyType,
+  ) {}
+
+  emit(): string {
+    return stripIndent`
+      type ${this.goName} = ${renderType(this.target)}`;
+  }
+}
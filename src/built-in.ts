@@ -1,5 +1,13 @@
-import { Optional } from "./common.js";
+import { Encoding, Optional } from "./common.js";
+import { PropertyType } from "./model.js";
+import { ScalarSymbol } from "./scalar.js";
 import { BaseSymbol, SymbolTable } from "./symbol.js";
+import { ValueUnionSymbol } from "./union.js";
+
+export interface SerializationFunctions {
+  serializeFunction: string;
+  deserializeFunction: string;
+}
 
 export class BuiltInSymbol implements BaseSymbol {
   public readonly kind: "built-in" = "built-in";
@@ -11,7 +19,34 @@ export class BuiltInSymbol implements BaseSymbol {
     public readonly include?: Optional<string>,
     public readonly serializeFunction?: Optional<string>,
     public readonly deserializeFunction?: Optional<string>,
+    public readonly encodings: Record<string, SerializationFunctions> = {},
   ) {}
+
+  /* Returns the helpers for the given @encode encoding, falling back to the default ones of the scalar. */
+  getSerializationFunctions(encoding: Optional<Encoding>): Optional<SerializationFunctions> {
+    /* @encode(string) has no encoding name, its helpers are registered under the name of the target type. */
+    const name = encoding?.name ?? encoding?.encodedAs;
+    if (name !== undefined) {
+      /* Encodings depending on the width of the target type, like unixTimestamp as int32, have their own helpers. */
+      const sizedEncoding =
+        encoding?.encodedAs !== undefined ? this.encodings[`${name}:${encoding.encodedAs}`] : undefined;
+      if (sizedEncoding !== undefined) {
+        return sizedEncoding;
+      }
+      /* Numeric encodings have a separate set of helpers when encoded as a floating point number. */
+      const floatEncoding = this.encodings[`${name}:float`];
+      if (floatEncoding !== undefined && encoding?.encodedAs !== undefined && floatTypes.includes(encoding.encodedAs)) {
+        return floatEncoding;
+      }
+      if (this.encodings[name] !== undefined) {
+        return this.encodings[name];
+      }
+    }
+    if (this.serializeFunction === undefined || this.deserializeFunction === undefined) {
+      return undefined;
+    }
+    return { serializeFunction: this.serializeFunction, deserializeFunction: this.deserializeFunction };
+  }
 }
 
 export class BuiltInTemplate implements BaseSymbol {
@@ -24,19 +59,100 @@ export class BuiltInTemplate implements BaseSymbol {
   ) {}
 }
 
+/* Integers encoded as strings, so that values beyond the safe integers of JavaScript survive. */
+function integerEncodings(goName: string): Record<string, SerializationFunctions> {
+  const helper = goName.startsWith("uint") ? "Uint" : "Int";
+  return {
+    string: {
+      serializeFunction: `serialize${helper}StringInternal[${goName}]`,
+      deserializeFunction: `unmarshal${helper}StringInternal[${goName}]`,
+    },
+  };
+}
+
+function integerType(name: string, goName: string): BuiltInSymbol {
+  return new BuiltInSymbol(name, goName, undefined, undefined, undefined, integerEncodings(goName));
+}
+
 export const integerTypes = [
-  new BuiltInSymbol("integer", "int64"),
-  new BuiltInSymbol("int64", "int64"),
-  new BuiltInSymbol("int32", "int32"),
-  new BuiltInSymbol("int16", "int16"),
-  new BuiltInSymbol("int8", "int8"),
-  new BuiltInSymbol("safeint", "int64"),
-  new BuiltInSymbol("uint64", "uint64"),
-  new BuiltInSymbol("uint32", "uint32"),
-  new BuiltInSymbol("uint16", "uint16"),
-  new BuiltInSymbol("uint8", "uint8"),
+  integerType("integer", "int64"),
+  integerType("int64", "int64"),
+  integerType("int32", "int32"),
+  integerType("int16", "int16"),
+  integerType("int8", "int8"),
+  integerType("safeint", "int64"),
+  integerType("uint64", "uint64"),
+  integerType("uint32", "uint32"),
+  integerType("uint16", "uint16"),
+  integerType("uint8", "uint8"),
 ];
 
+/* Go types of the built-ins holding numbers which can be compared and written as constants. */
+export const numericTypes = [...integerTypes.map((t) => t.goName), "float32", "float64"];
+
+/* Returns the built-in of a property typed by a built-in or by a scalar extending one. */
+export function getBuiltIn(type: PropertyType): Optional<BuiltInSymbol> {
+  if (type.kind !== "model") {
+    return undefined;
+  } else if (type.type.kind === "built-in") {
+    return type.type as BuiltInSymbol;
+  } else if (type.type.kind === "scalar") {
+    return (type.type as ScalarSymbol).getBuiltIn();
+  }
+  return undefined;
+}
+
+/* Returns the Go type holding the values of a property, like string for scalars extending string. */
+export function getUnderlyingType(type: PropertyType): Optional<string> {
+  if (type.kind === "model" && type.type.kind === "value_union") {
+    return (type.type as ValueUnionSymbol).type?.goName;
+  }
+  return getBuiltIn(type)?.goName;
+}
+
+const floatTypes = ["numeric", "float", "float32", "float64", "decimal", "decimal128"];
+
+const durationEncodings: Record<string, SerializationFunctions> = {
+  seconds: {
+    serializeFunction: "serializeDurationSecondsInternal",
+    deserializeFunction: "unmarshalDurationSecondsInternal",
+  },
+  "seconds:float": {
+    serializeFunction: "serializeDurationSecondsFloatInternal",
+    deserializeFunction: "unmarshalDurationSecondsFloatInternal",
+  },
+  milliseconds: {
+    serializeFunction: "serializeDurationMillisecondsInternal",
+    deserializeFunction: "unmarshalDurationMillisecondsInternal",
+  },
+  "milliseconds:float": {
+    serializeFunction: "serializeDurationMillisecondsFloatInternal",
+    deserializeFunction: "unmarshalDurationMillisecondsFloatInternal",
+  },
+};
+
+const dateTimeEncodings: Record<string, SerializationFunctions> = {
+  rfc7231: {
+    serializeFunction: "serializeDateTimeRFC7231Internal",
+    deserializeFunction: "unmarshalDateTimeRFC7231Internal",
+  },
+  unixTimestamp: {
+    serializeFunction: "serializeDateTimeUnixTimestampInternal",
+    deserializeFunction: "unmarshalDateTimeUnixTimestampInternal",
+  },
+  "unixTimestamp:int32": {
+    serializeFunction: "serializeDateTimeUnixTimestampInt32Internal",
+    deserializeFunction: "unmarshalDateTimeUnixTimestampInt32Internal",
+  },
+};
+
+const decimalEncodings: Record<string, SerializationFunctions> = {
+  string: {
+    serializeFunction: "serializeDecimalStringInternal",
+    deserializeFunction: "unmarshalDecimalStringInternal",
+  },
+};
+
 export const builtInTemplates = [new BuiltInTemplate("Array", "Array"), new BuiltInTemplate("Record", "Record")];
 
 export const builtInSymbols = [
@@ -45,14 +161,41 @@ export const builtInSymbols = [
   ...integerTypes,
   new BuiltInSymbol("float32", "float32"),
   new BuiltInSymbol("float64", "float64"),
-  // new BuiltInSymbol("decimal", ""),
-  // new BuiltInSymbol("decimal128", ""),
-  // new BuiltInSymbol("plainDate", ""),
-  // new BuiltInSymbol("plainTime", ""),
-  // new BuiltInSymbol("utcDateTime", ""),
-  // new BuiltInSymbol("offsetDateTime", ""),
-  new BuiltInSymbol("duration", "time.Duration", "time", "serializeDurationInternal", "unmarshalDurationInternal"),
-  // new BuiltInSymbol("bytes", ""),
+  new BuiltInSymbol("decimal", "Decimal", undefined, undefined, undefined, decimalEncodings),
+  new BuiltInSymbol("decimal128", "Decimal", undefined, undefined, undefined, decimalEncodings),
+  new BuiltInSymbol("plainDate", "Date", undefined, "serializePlainDateInternal", "unmarshalPlainDateInternal"),
+  new BuiltInSymbol("plainTime", "TimeOfDay", undefined, "serializePlainTimeInternal", "unmarshalPlainTimeInternal"),
+  new BuiltInSymbol(
+    "utcDateTime",
+    "time.Time",
+    "time",
+    "serializeUtcDateTimeInternal",
+    "unmarshalUtcDateTimeInternal",
+    dateTimeEncodings,
+  ),
+  new BuiltInSymbol(
+    "offsetDateTime",
+    "time.Time",
+    "time",
+    "serializeOffsetDateTimeInternal",
+    "unmarshalOffsetDateTimeInternal",
+    dateTimeEncodings,
+  ),
+  new BuiltInSymbol(
+    "duration",
+    "time.Duration",
+    "time",
+    "serializeDurationInternal",
+    "unmarshalDurationInternal",
+    durationEncodings,
+  ),
+  new BuiltInSymbol("bytes", "[]byte", undefined, "serializeBytesBase64Internal", "unmarshalBytesBase64Internal", {
+    base64url: {
+      serializeFunction: "serializeBytesBase64URLInternal",
+      deserializeFunction: "unmarshalBytesBase64URLInternal",
+    },
+  }),
+  new BuiltInSymbol("url", "url.URL", "net/url", "serializeURLInternal", "unmarshalURLInternal"),
   new BuiltInSymbol("string", "string"),
   new BuiltInSymbol("boolean", "bool"),
   ...builtInTemplates,
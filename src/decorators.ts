@@ -0,0 +1,20 @@
+import { DecoratorContext, Model, Program } from "@typespec/compiler";
+import { Optional } from "./common.js";
+import { $lib, UnknownPropertiesMode } from "./lib.js";
+
+const unknownPropertiesKey = $lib.createStateSymbol("unknownProperties");
+
+export function $unknownProperties(context: DecoratorContext, target: Model, mode: UnknownPropertiesMode) {
+  context.program.stateMap(unknownPropertiesKey).set(target, mode);
+}
+
+/* Returns the mode set on the model with @unknownProperties, undefined when the option applies. */
+export function getUnknownPropertiesMode(program: Program, target: Model): Optional<UnknownPropertiesMode> {
+  return program.stateMap(unknownPropertiesKey).get(target);
+}
+
+export const $decorators = {
+  GoEmitter: {
+    unknownProperties: $unknownProperties,
+  },
+};
@@ -0,0 +1,128 @@
+import { Value } from "@typespec/compiler";
+import { getBuiltIn, getUnderlyingType, numericTypes } from "./built-in.js";
+import { Optional } from "./common.js";
+import { PropertyType } from "./model.js";
+import { ValueUnionSymbol } from "./union.js";
+
+/* Returns whether the fields of a date or a time are in range, the Go parsers reject the others. */
+function isValidDateTime(year: number, month: number, day: number, hour = 0, minute = 0, second = 0): boolean {
+  const date = new Date(Date.UTC(year, month - 1, day));
+  return (
+    date.getUTCFullYear() === year &&
+    date.getUTCMonth() === month - 1 &&
+    date.getUTCDate() === day &&
+    hour < 24 &&
+    minute < 60 &&
+    second < 60
+  );
+}
+
+/* Returns whether the text is a date and time Go parses with time.RFC3339. */
+function isRFC3339(text: string): boolean {
+  const match = /^(\d{4})-(\d{2})-(\d{2})T(\d{2}):(\d{2}):(\d{2})(\.\d{1,9})?(Z|[+-](\d{2}):(\d{2}))$/.exec(text);
+  if (match === null || (match[9] !== undefined && (Number(match[9]) >= 24 || Number(match[10]) >= 60))) {
+    return false;
+  }
+  const [year, month, day, hour, minute, second] = match.slice(1, 7).map(Number);
+  return isValidDateTime(year, month, day, hour, minute, second);
+}
+
+/* Returns whether the text is an absolute URL url.Parse accepts, unmarshalURLInternal rejects the ones without a
+ * scheme. Whitespace and malformed percent escapes make url.Parse fail. */
+function isAbsoluteURL(text: string): boolean {
+  return /^[A-Za-z][A-Za-z0-9+.-]*:[^\s%]*(%[0-9A-Fa-f]{2}[^\s%]*)*$/.test(text);
+}
+
+/* Go expressions parsing the ISO 8601 text of the values of the built-ins created through fromISO, along with the
+   check of the text so that an invalid default is reported rather than panicking when the package is initialized. */
+const isoParsers: Record<string, { isValid: (text: string) => boolean; render: (text: string) => string }> = {
+  duration: {
+    isValid: (text) =>
+      /^-?P(?!$)(\d+([.,]\d+)?W)?(\d+([.,]\d+)?D)?(T(?=\d)(\d+([.,]\d+)?H)?(\d+([.,]\d+)?M)?(\d+([.,]\d+)?S)?)?$/.test(
+        text,
+      ),
+    render: (text) => `mustDefault(parseDurationISO8601(${text}))`,
+  },
+  /* utcDateTime values are kept in UTC whatever the offset of the text. */
+  utcDateTime: {
+    isValid: isRFC3339,
+    render: (text) => `mustDefault(time.Parse(time.RFC3339, ${text})).UTC()`,
+  },
+  offsetDateTime: {
+    isValid: isRFC3339,
+    render: (text) => `mustDefault(time.Parse(time.RFC3339, ${text}))`,
+  },
+  plainDate: {
+    isValid: (text) => {
+      const match = /^(\d{4})-(\d{2})-(\d{2})$/.exec(text);
+      return match !== null && isValidDateTime(Number(match[1]), Number(match[2]), Number(match[3]));
+    },
+    render: (text) => `mustDefault(ParseDate(${text}))`,
+  },
+  plainTime: {
+    isValid: (text) => {
+      const match = /^(\d{2}):(\d{2}):(\d{2})(\.\d{1,9})?$/.exec(text);
+      return match !== null && isValidDateTime(2000, 1, 1, Number(match[1]), Number(match[2]), Number(match[3]));
+    },
+    render: (text) => `mustDefault(ParseTimeOfDay(${text}))`,
+  },
+};
+
+/* Default value of a property, Go only has constants of basic types so the others are package level variables. */
+export interface PropertyDefault {
+  name: string;
+  value: string;
+  constant: boolean;
+}
+
+/* Renders the default of a property typed type, undefined for the values Go can't express. */
+export function renderDefault(name: string, value: Value, type: PropertyType): Optional<PropertyDefault> {
+  const underlyingType = getUnderlyingType(type);
+  if (type.kind !== "model" || underlyingType === undefined) {
+    return undefined;
+  }
+  const constant = (value: string): PropertyDefault => ({ name, value, constant: true });
+  /* Variables are typed by their value, converted to the named type of the property when there's one. */
+  const variable = (value: string): PropertyDefault => ({
+    name,
+    value: type.type.goName !== underlyingType ? `${type.type.goName}(${value})` : value,
+    constant: false,
+  });
+  switch (value.valueKind) {
+    case "BooleanValue":
+      return underlyingType === "bool" ? constant(`${value.value}`) : undefined;
+    case "StringValue":
+      if (underlyingType === "string") {
+        return constant(JSON.stringify(value.value));
+      } else if (underlyingType === "url.URL" && isAbsoluteURL(value.value)) {
+        return variable(`*mustDefault(url.Parse(${JSON.stringify(value.value)}))`);
+      }
+      return undefined;
+    case "NumericValue":
+      if (numericTypes.includes(underlyingType)) {
+        return constant(value.value.toString());
+      } else if (underlyingType === "Decimal") {
+        return variable(`mustDefault(NewDecimal(${JSON.stringify(value.value.toString())}))`);
+      }
+      return undefined;
+    case "EnumValue": {
+      if (type.type.kind !== "value_union") {
+        return undefined;
+      }
+      const symbol = type.type as ValueUnionSymbol;
+      const variant = symbol.variants.find((v) => v.name === value.value.name);
+      return variant !== undefined ? constant(`${symbol.goName}${variant.goName}`) : undefined;
+    }
+    case "ScalarValue": {
+      const [arg] = value.value.args;
+      const builtIn = getBuiltIn(type);
+      const parser = builtIn !== undefined ? isoParsers[builtIn.name] : undefined;
+      if (value.value.name !== "fromISO" || arg?.valueKind !== "StringValue" || !parser?.isValid(arg.value)) {
+        return undefined;
+      }
+      return variable(parser.render(JSON.stringify(arg.value)));
+    }
+    default:
+      return undefined;
+  }
+}
@@ -1,34 +1,74 @@
 import {
   EmitContext,
+  Enum,
+  getDoc,
+  getFriendlyName,
+  isTemplateDeclaration,
+  isTemplateInstance,
+  ListenerFlow,
   Model,
   ModelProperty,
   Namespace,
   navigateProgram,
   navigateTypesInNamespace,
+  NoTarget,
+  Scalar,
+  SemanticNodeListener,
+  SyntaxKind,
+  Tuple,
+  Type,
   Union,
   UnionVariant,
 } from "@typespec/compiler";
 import { createRekeyableMap } from "@typespec/compiler/utils";
 import { camelCase, pascalCase } from "change-case";
 import {
+  emitCivilTypes,
+  emitDecimal,
   emitHeader,
+  emitMissingPropertiesError,
+  emitMustDefault,
   emitNullable,
+  emitPathHelpers,
+  emitUnknownPropertiesHelpers,
   emitPtr,
   emitSerializationHelpers,
+  emitUnknown,
+  Encoding,
+  emitValidation,
+  getAliasName,
+  getConstraints,
   getDiscriminator,
-  getDoc,
+  getNullableType,
   getEncodedName,
+  getEncoding,
+  getEnumMemberValue,
   getLiteralValue,
   getMetadata,
+  Optional,
   storeMetadata,
   supportedLiteral,
 } from "./common.js";
 import { TypeUnionSymbol, UnionSymbol, ValueUnionSymbol } from "./union.js";
-import { ModelSymbol, PropertyType } from "./model.js";
+import {
+  breakReferenceCycles,
+  getReferencedSymbols,
+  ModelSymbol,
+  needsCustomSerialization,
+  PropertyType,
+  wrapsErrors,
+} from "./model.js";
 import { BaseSymbol, SymbolTable } from "./symbol.js";
 import { addBuiltInSymbols, BuiltInSymbol, BuiltInTemplate } from "./built-in.js";
+import { ScalarSymbol } from "./scalar.js";
+import { TupleSymbol } from "./tuple.js";
+import { AliasSymbol } from "./alias.js";
+import { getUnknownPropertiesMode } from "./decorators.js";
+import { renderDefault } from "./defaults.js";
+import { GoEmitterOptions, reportDiagnostic } from "./lib.js";
+import { getApplicableConstraints, getConstraintIncludes } from "./validation.js";
 
-type Symbol = UnionSymbol | ModelSymbol | BuiltInSymbol | BuiltInTemplate;
+type Symbol = UnionSymbol | ModelSymbol | ScalarSymbol | TupleSymbol | AliasSymbol | BuiltInSymbol | BuiltInTemplate;
 
 interface NamespaceDefinition {
   name: string;
@@ -37,12 +77,44 @@ interface NamespaceDefinition {
   symbols: Symbol[];
 }
 
-export async function $onEmit(context: EmitContext): Promise<void> {
+export async function $onEmit(context: EmitContext<GoEmitterOptions>): Promise<void> {
   const { program } = context;
   const builtInNamespaces = ["", "TypeSpec", "Reflection"];
   const namespaces = new Map<string, NamespaceDefinition>();
   const symbolTable = new SymbolTable<Symbol>();
   addBuiltInSymbols(symbolTable);
+  /* unknown is kept as raw JSON unless the any type was asked for. */
+  const unknownGoType = context.options["unknown-type"] === "any" ? "any" : "json.RawMessage";
+  symbolTable.push(new BuiltInSymbol("unknown", unknownGoType));
+  const enforceRequired = context.options["required-properties"] === "enforce";
+  const unknownProperties = context.options["unknown-properties"] ?? "ignore";
+  const preserveUnknown = context.options["preserve-unknown-properties"] ?? false;
+  /* Tuples have no name, the symbols generated for them are tracked by type instead. */
+  const tupleSymbols = new Map<Tuple, TupleSymbol>();
+  /* Template instances are all named like their template, their monomorphized symbols are tracked by type. */
+  const templateInstances = new Map<Model, ModelSymbol>();
+
+  const getTypeName = (type: Type): string => {
+    if (type.kind === "Model" && type.templateMapper !== undefined) {
+      return `${pascalCase(type.name)}${getTemplateArgsName(type)}`;
+    }
+    if ("name" in type && typeof type.name === "string") {
+      return pascalCase(type.name);
+    }
+    throw new Error(`Unsupported template argument kind ${type.kind}`);
+  };
+  const getTemplateArgsName = (model: Model): string =>
+    (model.templateMapper?.args ?? [])
+      .map((arg) => {
+        if (arg.entityKind !== "Type") {
+          throw new Error("Unsupported arg entity kind");
+        }
+        return getTypeName(arg);
+      })
+      .join("");
+  /* Name chosen by the spec author for an anonymous type, through @friendlyName or an alias. */
+  const getDeclaredName = (type: Model | Union): Optional<string> =>
+    getFriendlyName(program, type) ?? getAliasName(type);
 
   navigateProgram(program, {
     namespace: (namespace: Namespace) => {
@@ -81,6 +153,125 @@ export async function $onEmit(context: EmitContext): Promise<void> {
   }
   type Scope = ModelScope | PropertyScope | UnionScope | UnionVariantScope;
 
+  /* Wrappers of the anonymous variants of type unions are named after the variant, like PaymentCard. */
+  const variantNames = new Map<Type, string>();
+
+  /* Anonymous types are named after the path leading to them, like ModelProperty or UnionVariant, unless an alias
+   * or @friendlyName names them. Elements of arrays take an Item segment, elements of records and variants wrapped
+   * by their union a Value one. */
+  const nameAnonymousTypes = (namespace: NamespaceDefinition) => {
+    const visited = new Set<Type>();
+    const { models, scalars, unions, enums } = namespace.typespecDefinition;
+    /* Go names are PascalCase, an anonymous union named itemKind clashes with a model named ItemKind. */
+    const isDeclared = (name: string) =>
+      [models, scalars, unions, enums].some((types) =>
+        [...types.keys()].some((declared) => pascalCase(declared) === pascalCase(name)),
+      );
+    const declare = (type: Model | Union) => {
+      if (isDeclared(type.name!)) {
+        reportDiagnostic(program, { code: "duplicate-type-name", format: { name: type.name! }, target: type });
+        return false;
+      }
+      if (type.kind === "Model") {
+        /* Like unions, so that the ones held by arrays and records, which aren't navigated, are emitted too */
+        models.set(type.name, type);
+      } else {
+        unions.set(type.name!, type);
+      }
+      return true;
+    };
+    /* Returns the variant of anonymous unions like Pet | null, the union listener types their properties by it */
+    const getUnwrappedVariant = (type: Type): Optional<Type> => {
+      if (type.kind !== "Union" || type.name !== undefined) {
+        return undefined;
+      }
+      const variants = [...type.variants.values()]
+        .map((v) => v.type)
+        .filter((t) => !(t.kind === "Intrinsic" && t.name === "null"));
+      return variants.length === 1 && !supportedLiteral(variants[0]) ? variants[0] : undefined;
+    };
+    /* Unions of literals name the values a collection holds, they keep the name of the collection */
+    const isEnumLike = (type: Type) =>
+      type.kind === "Union" &&
+      [...type.variants.values()].every(
+        (v) => supportedLiteral(v.type) || (v.type.kind === "Intrinsic" && v.type.name === "null"),
+      );
+    const visitProperties = (model: Model, prefix: string) => {
+      for (const property of model.properties.values()) {
+        const name = `${prefix}${pascalCase(property.name)}`;
+        const jsonName = getEncodedName(property, "application/json") || property.name;
+        visit(getUnwrappedVariant(property.type) ?? property.type, name, jsonName);
+      }
+      if (model.indexer !== undefined && model.indexer.key.name === "string") {
+        visit(model.indexer.value, `${prefix}AdditionalProperties`);
+      }
+    };
+    const visitVariants = (union: Union, prefix: string) => {
+      const wrapped = getDiscriminator(union) === undefined;
+      [...union.variants.values()].forEach((variant, i) => {
+        const variantName = typeof variant.name === "string" ? pascalCase(variant.name) : `Variant${i}`;
+        const isAnonymous =
+          (variant.type.kind === "Model" || variant.type.kind === "Union") &&
+          (variant.type.name === undefined || variant.type.name === "");
+        if (wrapped && isAnonymous) {
+          variantNames.set(variant.type, variantName);
+          visit(variant.type, `${prefix}${variantName}Value`);
+        } else {
+          visit(variant.type, `${prefix}${variantName}`);
+        }
+      });
+    };
+    /* jsonName is the one of the property holding the type, tuples prefix the errors of their items with it */
+    const visit = (type: Type, name: string, jsonName: string = "") => {
+      if (visited.has(type)) {
+        return;
+      }
+      visited.add(type);
+      if (type.kind === "Model" && isTemplateInstance(type)) {
+        const args = (type.templateMapper?.args ?? []).filter((a): a is Type => a.entityKind === "Type");
+        const isBuiltIn = builtInNamespaces.includes(type.namespace?.name ?? "");
+        if (isBuiltIn && (type.name === "Array" || type.name === "Record") && !isEnumLike(args[0])) {
+          visit(args[0], `${name}${type.name === "Array" ? "Item" : "Value"}`, jsonName);
+        } else {
+          args.forEach((arg, i) => visit(arg, args.length > 1 ? `${name}Arg${i}` : name));
+        }
+        if (!isBuiltIn) {
+          const instanceName = getFriendlyName(program, type) ?? `${type.name}${getTemplateArgsName(type)}`;
+          visitProperties(type, pascalCase(instanceName));
+        }
+      } else if (type.kind === "Model" && (type.name === undefined || type.name === "")) {
+        type.name = getDeclaredName(type) ?? name;
+        if (declare(type)) {
+          visitProperties(type, pascalCase(type.name));
+        }
+      } else if (type.kind === "Union" && type.name === undefined) {
+        const nullableType = getNullableType(type);
+        if (nullableType !== undefined) {
+          /* Nullable elements like (string | null)[] are emitted as pointers, not as unions */
+          visit(nullableType, name, jsonName);
+          return;
+        }
+        type.name = getDeclaredName(type) ?? camelCase(name);
+        if (declare(type)) {
+          visitVariants(type, pascalCase(type.name));
+        }
+      } else if (type.kind === "Tuple") {
+        /* Tuples are named after their position, nested tuples after their index in the enclosing tuple */
+        const symbol = new TupleSymbol(name, namespace.name, pascalCase(name), jsonName);
+        symbolTable.push(symbol);
+        tupleSymbols.set(type, symbol);
+        type.values.forEach((value, i) => visit(value, `${name}Item${i}`, `${jsonName}[${i}]`));
+      }
+    };
+    /* Copied, the named anonymous unions are added to the namespace */
+    const declaredModels = [...models.values()];
+    const declaredUnions = [...unions.values()];
+    declaredModels.forEach((model) => visited.add(model));
+    declaredUnions.forEach((union) => visited.add(union));
+    declaredModels.forEach((model) => visitProperties(model, pascalCase(model.name)));
+    declaredUnions.forEach((union) => visitVariants(union, pascalCase(union.name ?? "")));
+  };
+
   for (const namespace of namespaces.values()) {
     const scopes: Scope[] = [];
     for (const [name, model] of namespace.typespecDefinition.models) {
@@ -118,32 +309,58 @@ export async function $onEmit(context: EmitContext): Promise<void> {
         namespace.typespecDefinition.models.delete(name);
       }
     }
-    navigateTypesInNamespace(namespace.typespecDefinition, {
+    nameAnonymousTypes(namespace);
+    const listeners: SemanticNodeListener = {
       model: (model: Model) => {
         if (model.name === undefined || model.name === "") {
-          const parentScope = scopes[scopes.length - 1];
-          if (parentScope.type !== "property") {
-            throw new Error("Expected property scope");
+          throw new Error("Name of anonymous model not defined");
+        }
+        if (isTemplateInstance(model)) {
+          /* Only emitted when the generic model can't be used, see isGenericInstance */
+          const name = getFriendlyName(program, model) ?? `${model.name}${getTemplateArgsName(model)}`;
+          const symbol = new ModelSymbol(
+            name,
+            model.namespace?.name,
+            pascalCase(name),
+            getDoc(program, model),
+            () => undefined,
+          );
+          symbol.enforceRequired = enforceRequired;
+          symbol.unknownProperties = getUnknownPropertiesMode(program, model) ?? unknownProperties;
+          symbol.preservesUnknown = preserveUnknown;
+          symbolTable.push(symbol);
+          templateInstances.set(model, symbol);
+          scopes.push({ type: "model", symbol: symbol });
+          return;
+        }
+        const goName = getEncodedName(model, "text/x-go") || pascalCase(getFriendlyName(program, model) ?? model.name);
+        const doc = getDoc(program, model);
+        const baseModel = model.baseModel;
+        /* The base model may not have been visited yet, it is looked up once all symbols are known. */
+        const resolveBaseModel =
+          baseModel !== undefined ? symbolTable.deferResolve(baseModel.name, baseModel.namespace?.name) : undefined;
+        const parent = () => {
+          if (baseModel === undefined || resolveBaseModel === undefined) {
+            return undefined;
           }
-          model.name = `${pascalCase(parentScope.model.name)}${pascalCase(parentScope.name)}`;
-        }
-        const goName = getEncodedName(model, "text/x-go") || pascalCase(model.name);
-        const doc = getDoc(model);
-        const parent = (() => {
-          if (model.baseModel !== undefined) {
-            const parent = symbolTable.find(model.baseModel.name, model.baseModel.namespace?.name);
-            if (parent === undefined) {
-              throw new Error(`Parent model ${model.baseModel.name} not found.`);
-            }
-            if (parent.kind !== "model") {
-              throw new Error(`Parent ${model.baseModel.name} is not a model.`);
-            }
-            return parent;
+          const parent = resolveBaseModel();
+          if (parent === undefined) {
+            throw new Error(`Parent model ${baseModel.name} not found.`);
           }
-          return undefined;
-        })();
+          if (parent.kind !== "model") {
+            throw new Error(`Parent ${baseModel.name} is not a model.`);
+          }
+          return parent;
+        };
 
-        const symbol = new ModelSymbol(model.name, model.namespace?.name, goName, doc, parent);
+        const typeParameters =
+          isTemplateDeclaration(model) && model.node !== undefined && "templateParameters" in model.node
+            ? model.node.templateParameters.map((p) => p.id.sv)
+            : [];
+        const symbol = new ModelSymbol(model.name, model.namespace?.name, goName, doc, parent, typeParameters);
+        symbol.enforceRequired = enforceRequired;
+        symbol.unknownProperties = getUnknownPropertiesMode(program, model) ?? unknownProperties;
+        symbol.preservesUnknown = preserveUnknown;
         symbolTable.push(symbol);
         scopes.push({ type: "model", symbol: symbol });
       },
@@ -156,50 +373,62 @@ export async function $onEmit(context: EmitContext): Promise<void> {
           throw new Error("Expected model scope");
         }
         scopes.push({ type: "property", name: property.name, model: parentScope.symbol, tspDef: property });
-        const { type } = property;
-        if (type.kind === "Model") {
-          /* Anonymous unions are not included in the traversal, here we name and add them */
-          for (const t of type.templateMapper?.args.values() || []) {
-            if (t.entityKind === "Type") {
-              if (t.kind === "Union" && t.name === undefined) {
-                t.name = `${camelCase(parentScope.symbol.name)}${pascalCase(property.name)}`;
-                namespace.typespecDefinition.unions.set(t.name, t);
-              }
-            }
-          }
-        }
       },
       exitModelProperty: (_: ModelProperty) => {
         scopes.pop();
       },
+      scalar: (scalar: Scalar) => {
+        if (builtInNamespaces.includes(scalar.namespace?.name ?? "")) {
+          return;
+        }
+        const goName =
+          getEncodedName(scalar, "text/x-go") || pascalCase(getFriendlyName(program, scalar) ?? scalar.name);
+        const symbol = new ScalarSymbol(
+          scalar.name,
+          scalar.namespace?.name,
+          goName,
+          getDoc(program, scalar),
+          getEncoding(scalar),
+        );
+        symbol.constraints = getConstraints(scalar);
+        symbolTable.push(symbol);
+      },
+      enum: (en: Enum) => {
+        const goName = getEncodedName(en, "text/x-go") || pascalCase(getFriendlyName(program, en) ?? en.name);
+        const doc = getDoc(program, en);
+        const symbol = new ValueUnionSymbol(en.name, en.namespace?.name, goName, doc, false);
+        symbolTable.push(symbol);
+      },
       union: (union: Union) => {
+        if (isTemplateDeclaration(union)) {
+          return ListenerFlow.NoRecursion;
+        }
         const parentScope = scopes[scopes.length - 1];
 
         const nullVariant = [...union.variants.entries()].find(
           ([_, v]) => v.type.kind === "Intrinsic" && v.type.name === "null",
         );
-        if (nullVariant !== undefined) {
-          union.variants.delete(nullVariant[0]);
-        }
 
+        /* Anonymous unions left unnamed by nameAnonymousTypes hold a single type besides null */
         if (union.name === undefined) {
-          if (parentScope.type === "property") {
-            if (union.variants.size === 1) {
-              if (!supportedLiteral([...union.variants.values()][0].type)) {
-                /* Anonymous type unions with a single can just be removed */
-                const variant = [...union.variants.values()][0];
-                parentScope.tspDef.type = variant.type;
-                storeMetadata(parentScope.tspDef, "nullable", `${nullVariant !== undefined}`);
-                return;
-              }
+          if (parentScope?.type === "property" && parentScope.tspDef.type === union) {
+            /* Anonymous type unions with a single variant can just be removed */
+            const variant = [...union.variants.values()].find((v) => v !== nullVariant?.[1]);
+            if (variant === undefined) {
+              throw new Error(`Union of property ${parentScope.name} has no variant`);
             }
-            const propertyName = parentScope.name;
-            const modelName = parentScope.model.name;
-            const generatedName = `${camelCase(modelName)}${pascalCase(propertyName)}`;
-            union.name = generatedName;
-          } else {
-            throw new Error("Anonymous union not contained in a property");
+            parentScope.tspDef.type = variant.type;
+            storeMetadata(parentScope.tspDef, "nullable", `${nullVariant !== undefined}`);
+            return;
+          } else if (getNullableType(union) === undefined) {
+            throw new Error("Name of anonymous union not defined");
           }
+          /* Nullable elements, resolved as pointers by resolvePropertyType */
+          return;
+        }
+
+        if (nullVariant !== undefined) {
+          union.variants.delete(nullVariant[0]);
         }
 
         const scalars: Map<string | symbol, UnionVariant> = new Map();
@@ -257,8 +486,8 @@ export async function $onEmit(context: EmitContext): Promise<void> {
         }
         storeMetadata(union, "union_type", isValueUnion ? "values" : "types");
 
-        const goName = getEncodedName(union, "text/x-go") || pascalCase(union.name);
-        const doc = getDoc(union);
+        const goName = getEncodedName(union, "text/x-go") || pascalCase(getFriendlyName(program, union) ?? union.name);
+        const doc = getDoc(program, union);
 
         const discriminator = getDiscriminator(union);
 
@@ -275,18 +504,127 @@ export async function $onEmit(context: EmitContext): Promise<void> {
 
         symbolTable.push(symbol);
       },
-    });
+    };
+    navigateTypesInNamespace(namespace.typespecDefinition, listeners, { includeTemplateDeclaration: true });
   }
 
+  /* Template instances use the generic model unless an argument needs custom serialization, like type unions. */
+  const isGenericInstance = (model: Model): boolean =>
+    (model.templateMapper?.args ?? []).every(
+      (arg) => arg.entityKind === "Type" && !needsCustomSerialization(resolvePropertyType(arg, undefined)),
+    );
+
+  /* Resolves the type of a property, template instances like Array<Record<T>> are resolved recursively. */
+  const resolvePropertyType = (type: Type, encoding: Optional<Encoding>): PropertyType => {
+    if (type.kind === "TemplateParameter") {
+      return {
+        kind: "type_parameter",
+        name: type.node.id.sv,
+      };
+    }
+    const nullableType = type.kind === "Union" ? getNullableType(type) : undefined;
+    if (nullableType !== undefined) {
+      return {
+        kind: "nullable",
+        type: resolvePropertyType(nullableType, encoding),
+      };
+    }
+    const instance = type.kind === "Model" ? templateInstances.get(type) : undefined;
+    if (type.kind === "Model" && instance !== undefined) {
+      if (!isGenericInstance(type)) {
+        return {
+          kind: "model",
+          type: instance,
+          encoding,
+        };
+      }
+      const template = symbolTable.find(type.name, type.namespace?.name);
+      if (template?.kind !== "model") {
+        throw new Error(`Template ${type.name} not found.`);
+      }
+      return {
+        kind: "template_instance",
+        template,
+        args: (type.templateMapper?.args ?? []).map((arg) => {
+          if (arg.entityKind !== "Type") {
+            throw new Error("Unsupported arg entity kind");
+          }
+          return {
+            kind: "type",
+            type: resolvePropertyType(arg, undefined),
+          };
+        }),
+      };
+    }
+    if (type.kind === "Intrinsic" && type.name === "unknown") {
+      return {
+        kind: "model",
+        type: symbolTable.find("unknown", "TypeSpec")!,
+      };
+    }
+    if (type.kind === "Tuple") {
+      const symbol = tupleSymbols.get(type);
+      if (symbol === undefined) {
+        throw new Error("Tuple not contained in a property");
+      }
+      return {
+        kind: "model",
+        type: symbol,
+      };
+    }
+    if (type.kind !== "Scalar" && type.kind !== "Model" && type.kind !== "Union" && type.kind !== "Enum") {
+      throw new Error(`Unsupported type kind ${type.kind}`);
+    }
+    if (type.name === undefined) {
+      throw new Error("Name of union type not defined");
+    }
+    const symbol = symbolTable.find(type.name, type.namespace?.name);
+    if (symbol === undefined) {
+      throw new Error(`Type ${type.name} not found.`);
+    }
+    if (symbol.kind === "built-in-template" && type.kind !== "Enum") {
+      if (type.templateMapper?.args.length !== 1) {
+        throw new Error("Array or Record template must have exactly one argument.");
+      }
+      const arg = type.templateMapper.args[0];
+      if (arg.entityKind !== "Type") {
+        throw new Error("Unsupported arg entity kind");
+      }
+      return {
+        kind: "template_instance",
+        template: symbol,
+        args: [
+          {
+            kind: "type",
+            type: resolvePropertyType(arg, encoding),
+          },
+        ],
+      };
+    }
+    return {
+      kind: "model",
+      type: symbol,
+      encoding,
+    };
+  };
+
   for (const namespace of namespaces.values()) {
     const scopes: Scope[] = [];
 
-    navigateTypesInNamespace(namespace.typespecDefinition, {
+    const listeners: SemanticNodeListener = {
       model: (model: Model) => {
-        const symbol = symbolTable.find(model.name, model.namespace?.name);
+        const instance = templateInstances.get(model);
+        if (instance !== undefined && isGenericInstance(model)) {
+          return ListenerFlow.NoRecursion;
+        }
+        const symbol = instance ?? symbolTable.find(model.name, model.namespace?.name);
         if (symbol?.kind !== "model") {
           throw new Error(`Model ${model.name} not found.`);
         }
+        /* ...Record<T> and is Record<T> give the model a string indexer, arrays have an integer one. */
+        if (model.indexer !== undefined && model.indexer.key.name === "string") {
+          symbol.additionalProperties = resolvePropertyType(model.indexer.value, undefined);
+        }
         scopes.push({ type: "model", symbol: symbol });
       },
       exitModel: (_: Model) => {
@@ -312,58 +650,31 @@ export async function $onEmit(context: EmitContext): Promise<void> {
         const { name, model } = scope;
         const goName = getEncodedName(property, "text/x-go") || pascalCase(name);
         const jsonName = getEncodedName(property, "application/json") || name;
-        const doc = getDoc(property);
+        const doc = getDoc(program, property);
         const { type, optional } = property;
         if (
           type.kind === "Scalar" ||
           type.kind === "Model" ||
           type.kind === "Union" ||
           type.kind === "UnionVariant" ||
+          type.kind === "Enum" ||
+          type.kind === "EnumMember" ||
+          (type.kind === "Intrinsic" && type.name === "unknown") ||
+          type.kind === "Tuple" ||
+          type.kind === "TemplateParameter" ||
           supportedLiteral(type)
         ) {
           const propertyType = ((): PropertyType => {
-            if (type.kind === "Scalar" || type.kind === "Model" || type.kind === "Union") {
-              if (type.name === undefined) {
-                throw new Error("Name of union type not defined");
-              }
-              const symbol = symbolTable.find(type.name, type.namespace?.name);
-              if (symbol === undefined) {
-                throw new Error(`Type ${type.name} not found.`);
-              }
-              if (symbol.kind === "built-in-template") {
-                if (type.templateMapper?.args.length !== 1) {
-                  throw new Error("Array or Record template must have exactly one argument.");
-                }
-                const arg = type.templateMapper.args[0];
-                if (arg.entityKind !== "Type") {
-                  throw new Error("Unsupported arg entity kind");
-                }
-                if (arg.kind !== "Model" && arg.kind !== "Scalar" && arg.kind !== "Union") {
-                  throw new Error("Unsupported arg kind");
-                }
-                if (arg.name === undefined) {
-                  throw new Error("Union name not defined");
-                }
-                const argSymbol = symbolTable.find(arg.name, arg.namespace?.name);
-                if (argSymbol === undefined) {
-                  throw new Error(`Type ${arg.name} not found.`);
-                }
-                return {
-                  kind: "template_instance",
-                  template: symbol,
-                  args: [
-                    {
-                      kind: "type",
-                      symbol: argSymbol,
-                    },
-                  ],
-                };
-              } else {
-                return {
-                  kind: "model",
-                  type: symbol,
-                };
-              }
+            if (
+              type.kind === "Scalar" ||
+              type.kind === "Model" ||
+              type.kind === "Union" ||
+              type.kind === "Enum" ||
+              type.kind === "Intrinsic" ||
+              type.kind === "Tuple" ||
+              type.kind === "TemplateParameter"
+            ) {
+              return resolvePropertyType(type, getEncoding(property));
             } else if (supportedLiteral(type)) {
               const [typeName, value] = getLiteralValue(type);
               const symbol = symbolTable.find(typeName, "TypeSpec");
@@ -394,11 +705,35 @@ export async function $onEmit(context: EmitContext): Promise<void> {
                 type: symbol,
                 value: value,
               };
+            } else if (type.kind === "EnumMember") {
+              const symbol = symbolTable.find(type.enum.name, type.enum.namespace?.name);
+              if (symbol === undefined) {
+                throw new Error(`Type ${type.enum.name} not found.`);
+              }
+              const [_, value] = getEnumMemberValue(type);
+              return {
+                kind: "constant",
+                type: symbol,
+                value,
+              };
             }
             throw new Error("Unsupported type kind");
           })();
           const nullable = getMetadata(property, "nullable") === "true";
-          model.addProperty({
+          const defaultValue =
+            property.defaultValue !== undefined
+              ? renderDefault(`${model.goName}${goName}Default`, property.defaultValue, propertyType)
+              : undefined;
+          /* A null default is the zero value of a nullable property already. */
+          const isNullDefault = nullable && property.defaultValue?.valueKind === "NullValue";
+          if (property.defaultValue !== undefined && defaultValue === undefined && !isNullDefault) {
+            reportDiagnostic(program, {
+              code: "unsupported-default",
+              format: { name: property.name, model: model.name },
+              target: property,
+            });
+          }
+          const propertyDef = {
             name: property.name,
             goName,
             jsonName,
@@ -406,10 +741,84 @@ export async function $onEmit(context: EmitContext): Promise<void> {
             type: propertyType,
             optional,
             nullable,
+            constraints: getConstraints(property),
+            default: defaultValue,
+          };
+          /* Spread, is and intersections are flattened by the compiler, their properties may clash once renamed. */
+          const conflict = model.findConflictingProperty(propertyDef);
+          if (conflict !== undefined) {
+            reportDiagnostic(program, {
+              code: "duplicate-property",
+              format: {
+                name: property.name,
+                other: conflict.name,
+                model: model.name,
+                emittedName: conflict.goName === goName ? goName : `"${jsonName}"`,
+              },
+              target: property,
+            });
+            return;
+          }
+          model.addProperty(propertyDef);
+        }
+      },
+      tuple: (tuple: Tuple) => {
+        const symbol = tupleSymbols.get(tuple);
+        if (symbol === undefined) {
+          throw new Error("Tuple not contained in a property");
+        }
+        symbol.items = tuple.values.map((value) => resolvePropertyType(value, undefined));
+        namespace.symbols.push(symbol);
+      },
+      scalar: (scalar: Scalar) => {
+        if (builtInNamespaces.includes(scalar.namespace?.name ?? "")) {
+          return;
+        }
+        const symbol = symbolTable.find(scalar.name, scalar.namespace?.name);
+        if (symbol?.kind !== "scalar") {
+          throw new Error(`Scalar ${scalar.name} not found.`);
+        }
+        if (scalar.baseScalar === undefined) {
+          throw new Error(`Scalar ${scalar.name} must extend a built-in scalar.`);
+        }
+        const base = symbolTable.find(scalar.baseScalar.name, scalar.baseScalar.namespace?.name);
+        if (base?.kind !== "built-in" && base?.kind !== "scalar") {
+          throw new Error(`Base scalar ${scalar.baseScalar.name} of ${scalar.name} not found.`);
+        }
+        symbol.base = base;
+        namespace.symbols.push(symbol);
+      },
+      enum: (en: Enum) => {
+        const symbol = symbolTable.find(en.name, en.namespace?.name);
+        if (symbol?.kind !== "value_union") {
+          throw new Error(`Enum ${en.name} not found.`);
+        }
+        /* Spread members (...OtherEnum) are already copied into the members of the enum by the compiler. */
+        const members = [...en.members.values()].map((member) => ({ member, value: getEnumMemberValue(member) }));
+        const typeNames = new Set(members.map(({ value: [typeName] }) => typeName));
+        if (typeNames.has("string") && typeNames.size > 1) {
+          throw new Error(`Enum ${en.name} mixes string and numeric members.`);
+        }
+        const typeName = typeNames.has("numeric") ? "numeric" : typeNames.has("integer") ? "integer" : "string";
+        const typeSymbol = symbolTable.find(typeName, "TypeSpec");
+        if (typeSymbol === undefined) {
+          throw new Error(`Type ${typeName} not found.`);
+        }
+        symbol.checkAndSetType(typeSymbol, true);
+        for (const { member, value } of members) {
+          symbol.variants.push({
+            name: member.name,
+            goName: getEncodedName(member, "text/x-go") || pascalCase(member.name),
+            doc: getDoc(program, member),
+            value: value[1],
           });
         }
+        namespace.symbols.push(symbol);
       },
       union: (union: Union) => {
+        if (isTemplateDeclaration(union)) {
+          return ListenerFlow.NoRecursion;
+        }
         if (union.name === undefined) {
           throw new Error("Union name not defined");
         }
@@ -473,7 +882,7 @@ export async function $onEmit(context: EmitContext): Promise<void> {
 
             const variantName = typeof variant.name === "string" ? variant.name : camelCase(`${value.value}`);
             const goName = getEncodedName(variant, "text/x-go") || pascalCase(variantName);
-            const doc = getDoc(variant);
+            const doc = getDoc(program, variant);
             parentScope.symbol.variants.push({
               name: variantName,
               goName,
@@ -537,42 +946,115 @@ export async function $onEmit(context: EmitContext): Promise<void> {
             symbol.variants.push({
               name: variantType.name,
               goName: variantType.goName,
-              doc: getDoc(variant),
+              doc: getDoc(program, variant),
               typeSymbol: variantType,
               tag: discriminatorField,
             });
           } else {
             symbol.variants.push({
-              name: variantType.name,
-              goName: variantType.goName,
-              doc: getDoc(variant),
+              name: variantNames.get(variant.type) ?? variantType.name,
+              goName: variantNames.get(variant.type) ?? variantType.goName,
+              doc: getDoc(program, variant),
               typeSymbol: variantType,
             });
           }
         }
       },
-    });
+    };
+    navigateTypesInNamespace(namespace.typespecDefinition, listeners, { includeTemplateDeclaration: true });
+
+    /* Tuples held by arrays and records aren't navigated, the walker doesn't enter the built-in templates. */
+    for (const [tuple, symbol] of tupleSymbols) {
+      if (symbol.namespace === namespace.name && !namespace.symbols.includes(symbol)) {
+        symbol.items = tuple.values.map((value) => resolvePropertyType(value, undefined));
+        namespace.symbols.push(symbol);
+      }
+    }
   }
 
+  /* Aliases of named types become Go type aliases, aliases of anonymous types already named them. */
+  const getTypeAliases = (namespace: NamespaceDefinition): AliasSymbol[] => {
+    const node = namespace.typespecDefinition.node;
+    const exports = node !== undefined ? program.checker.getMergedSymbol(node.symbol)?.exports : undefined;
+    const isEmitted = (type: Type): boolean =>
+      (type.kind === "Model" || type.kind === "Scalar" || type.kind === "Union" || type.kind === "Enum") &&
+      type.name !== undefined &&
+      type.name !== "" &&
+      (!isTemplateInstance(type) ||
+        templateInstances.has(type as Model) ||
+        symbolTable.find(type.name, type.namespace?.name)?.kind === "built-in-template");
+    const aliases: AliasSymbol[] = [];
+    for (const sym of exports?.values() ?? []) {
+      const declaration = sym.declarations[0];
+      if (declaration?.kind !== SyntaxKind.AliasStatement || declaration.templateParameters.length > 0) {
+        continue;
+      }
+      const type = program.checker.getTypeForNode(declaration);
+      if (getAliasName(type) === sym.name || !isEmitted(type)) {
+        continue;
+      }
+      const target = resolvePropertyType(type, undefined);
+      aliases.push(new AliasSymbol(sym.name, namespace.name, pascalCase(sym.name), target));
+    }
+    return aliases;
+  };
+  if (context.options["type-aliases"]) {
+    for (const namespace of namespaces.values()) {
+      namespace.symbols.push(...getTypeAliases(namespace));
+    }
+  }
+
+  breakReferenceCycles(
+    [...namespaces.values()]
+      .flatMap((n) => n.symbols)
+      .filter((s): s is ModelSymbol | TupleSymbol => s.kind === "model" || s.kind === "tuple"),
+  );
+
   for (const namespace of namespaces.values()) {
     const packageDirectory = `${context.emitterOutputDir}/${namespace.goName}`;
     await program.host.mkdirp(`${packageDirectory}`);
     const modelsFile = `${packageDirectory}/models.go`;
     const utilsFile = `${packageDirectory}/utils.go`;
+    const validationFile = `${packageDirectory}/validation.go`;
 
-    const shouldEmit = (s: Symbol): s is UnionSymbol | ModelSymbol =>
-      ["model", "value_union", "type_union"].includes(s.kind);
+    const shouldEmit = (s: Symbol): s is UnionSymbol | ModelSymbol | ScalarSymbol | TupleSymbol | AliasSymbol =>
+      ["model", "value_union", "type_union", "scalar", "tuple", "alias"].includes(s.kind);
+
+    /* Models tracking unknown properties look for them in the values they hold, whatever the mode of these. */
+    if (namespace.symbols.some((s) => s.kind === "model" && s.unknownProperties !== "ignore")) {
+      for (const symbol of namespace.symbols) {
+        if (symbol.kind === "model" || symbol.kind === "tuple" || symbol.kind === "type_union") {
+          symbol.collectsUnknown = true;
+        }
+      }
+    }
+
+    const getIncludes = (types: PropertyType[]): string[] =>
+      types
+        .flatMap((t) => getReferencedSymbols(t))
+        .map((t) => t as Symbol)
+        .filter((t) => t.kind === "built-in" || t.kind === "scalar")
+        .filter((t) => t.include !== undefined)
+        .map((t) => t.include!);
 
     const includes = namespace.symbols.filter(shouldEmit).flatMap((s) => {
       if (s.kind === "model") {
-        return s
-          .getAllProperties()
-          .map((p) => p.type)
-          .filter((t) => t.kind === "model")
-          .map((t) => t.type as Symbol)
-          .filter((t) => t.kind === "built-in")
-          .filter((t) => t.include !== undefined)
-          .map((t) => t.include!);
+        const properties = s.getAllProperties().filter((p) => p.type.kind !== "constant");
+        const additionalProperties = s.getAdditionalProperties();
+        /* Errors of additional properties are prefixed with their key through fmt.Errorf. */
+        return [
+          ...(properties.some(wrapsErrors) || additionalProperties !== undefined ? ["fmt"] : []),
+          ...getIncludes([
+            ...properties.map((p) => p.type),
+            ...(additionalProperties !== undefined ? [additionalProperties] : []),
+          ]),
+        ];
+      } else if (s.kind === "scalar") {
+        return s.include !== undefined ? [s.include] : [];
+      } else if (s.kind === "tuple") {
+        return ["fmt", ...getIncludes(s.items)];
+      } else if (s.kind === "alias") {
+        return getIncludes([s.target]);
       } else {
         return [];
       }
@@ -580,7 +1062,7 @@ export async function $onEmit(context: EmitContext): Promise<void> {
 
     await program.host.writeFile(
       modelsFile,
-      emitHeader(namespace.goName, ["encoding/json", ...new Set(includes)]) +
+      emitHeader(namespace.goName, ["encoding/json", ...[...new Set(includes)].sort()]) +
         "\n" +
         namespace.symbols
           .filter(shouldEmit)
@@ -588,6 +1070,135 @@ export async function $onEmit(context: EmitContext): Promise<void> {
           .join("\n\n"),
     );
 
-    await program.host.writeFile(utilsFile, emitHeader(namespace.goName, ["encoding/json", "time"]) + "\n" + emitNullable() + "\n" + emitPtr() + "\n" + emitSerializationHelpers());
+    const validations = namespace.symbols
+      .filter(shouldEmit)
+      .map((s) => (s.kind === "alias" || s.kind === "value_union" ? undefined : s.emitValidation()))
+      .filter((v): v is string => v !== undefined);
+    if (validations.length > 0) {
+      /* Only the packages of the constraints actually checked are imported, Go rejects unused imports. */
+      const validationIncludes = namespace.symbols.filter(shouldEmit).flatMap((s) => {
+        if (s.kind === "model") {
+          return s
+            .getAllProperties()
+            .flatMap((p) => getConstraintIncludes(getApplicableConstraints(p.constraints, p.type)));
+        } else if (s.kind === "scalar") {
+          return getConstraintIncludes(s.getConstraints());
+        }
+        return [];
+      });
+      await program.host.writeFile(
+        validationFile,
+        emitHeader(namespace.goName, [...new Set(validationIncludes)].sort()) + "\n" + validations.join("\n\n"),
+      );
+    }
+
+    /* Built-in scalars held by the emitted types, utils.go only declares the helpers of these. */
+    const builtIns = new Set(
+      namespace.symbols
+        .filter(shouldEmit)
+        .flatMap((s): BaseSymbol[] => {
+          if (s.kind === "model") {
+            const additionalProperties = s.getAdditionalProperties();
+            return [
+              ...s.getAllProperties().map((p) => p.type),
+              ...(additionalProperties !== undefined ? [additionalProperties] : []),
+            ].flatMap((t) => getReferencedSymbols(t));
+          } else if (s.kind === "tuple") {
+            return s.items.flatMap((t) => getReferencedSymbols(t));
+          } else if (s.kind === "alias") {
+            return getReferencedSymbols(s.target);
+          } else if (s.kind === "type_union") {
+            return s.variants.map((v) => v.typeSymbol);
+          } else if (s.kind === "value_union") {
+            return s.type !== undefined ? [s.type] : [];
+          }
+          return [s];
+        })
+        .map((s) => (s.kind === "scalar" ? (s as ScalarSymbol).getBuiltIn() : s))
+        .filter((s) => s.kind === "built-in")
+        .map((s) => s.name),
+    );
+    const usesBuiltIn = (...names: string[]) => names.some((n) => builtIns.has(n));
+
+    /* Sections of utils.go, with the packages they import and the exported names they declare. */
+    const helpers: { code: string; imports: string[]; names: string[] }[] = [
+      {
+        code: emitNullable(),
+        imports: ["encoding/json"],
+        names: ["Nullable", "SetNullable", "UnsetNullable", "NullNullable"],
+      },
+      { code: emitPtr(), imports: [], names: ["Ptr"] },
+      { code: emitMustDefault(), imports: [], names: [] },
+      ...(usesBuiltIn("plainDate", "plainTime")
+        ? [
+            {
+              code: emitCivilTypes(),
+              imports: ["encoding/json", "time"],
+              names: ["Date", "DateOf", "ParseDate", "TimeOfDay", "TimeOfDayOf", "ParseTimeOfDay"],
+            },
+          ]
+        : []),
+      ...(usesBuiltIn("decimal", "decimal128")
+        ? [
+            {
+              code: emitDecimal(),
+              imports: ["encoding/json", "fmt", "math/big", "strconv"],
+              names: ["Decimal", "NewDecimal", "DecimalFromRat"],
+            },
+          ]
+        : []),
+      ...(usesBuiltIn("unknown")
+        ? [{ code: emitUnknown(), imports: ["encoding/json"], names: ["DecodeUnknown"] }]
+        : []),
+      {
+        code: emitSerializationHelpers(),
+        imports: ["encoding/base64", "encoding/json", "fmt", "math", "net/url", "strconv", "strings", "time"],
+        names: [],
+      },
+      { code: emitPathHelpers(), imports: ["fmt"], names: [] },
+      ...(namespace.symbols.some((s) => s.kind === "model" && s.enforceRequired)
+        ? [{ code: emitMissingPropertiesError(), imports: ["fmt", "strings"], names: ["MissingPropertiesError"] }]
+        : []),
+      ...(namespace.symbols.some((s) => s.kind === "model" && s.collectsUnknown)
+        ? [
+            {
+              code: emitUnknownPropertiesHelpers(),
+              imports: ["encoding/json", "fmt", "sort", "strings"],
+              names: ["UnknownPropertiesError"],
+            },
+          ]
+        : []),
+      ...(validations.length > 0
+        ? [
+            {
+              code: emitValidation(),
+              imports: ["fmt", "net", "net/mail", "net/url", "regexp", "strings", "time"],
+              names: ["ValidationError", "Violation"],
+            },
+          ]
+        : []),
+    ];
+
+    /* Go rejects a package declaring a name twice, types of the spec can't be named like the helpers they use. */
+    const helperNames = new Set(helpers.flatMap((h) => h.names));
+    for (const symbol of namespace.symbols.filter(shouldEmit)) {
+      if (helperNames.has(symbol.goName)) {
+        const { models, scalars, unions, enums } = namespace.typespecDefinition;
+        const type =
+          models.get(symbol.name) ?? scalars.get(symbol.name) ?? unions.get(symbol.name) ?? enums.get(symbol.name);
+        reportDiagnostic(program, {
+          code: "reserved-name",
+          format: { name: symbol.name, goName: symbol.goName },
+          target: type ?? NoTarget,
+        });
+      }
+    }
+
+    await program.host.writeFile(
+      utilsFile,
+      emitHeader(namespace.goName, [...new Set(helpers.flatMap((h) => h.imports))].sort()) +
+        "\n" +
+        helpers.map((h) => h.code).join("\n"),
+    );
   }
 }
@@ -1,2 +1,3 @@
+export { $decorators } from "./decorators.js";
 export { $onEmit } from "./emitter.js";
 export { $lib } from "./lib.js";
@@ -1,8 +1,65 @@
-import { createTypeSpecLibrary } from "@typespec/compiler";
+import { createTypeSpecLibrary, JSONSchemaType, paramMessage } from "@typespec/compiler";
+
+/* How decoders handle JSON properties matching no property of the model. */
+export type UnknownPropertiesMode = "ignore" | "warn" | "strict";
+
+export interface GoEmitterOptions {
+  /** Go type used for properties typed unknown, json.RawMessage (raw) keeps the value as is. */
+  "unknown-type"?: "raw" | "any";
+  /** Emits a Go type alias for each TypeSpec alias of a named type, like type PetList = []Pet. */
+  "type-aliases"?: boolean;
+  /** Whether decoding fails when a required property is missing (enforce) or leaves its zero value (ignore). */
+  "required-properties"?: "ignore" | "enforce";
+  /** Whether decoding ignores unknown JSON properties, records their paths (warn) or fails (strict). */
+  "unknown-properties"?: UnknownPropertiesMode;
+  /** Keeps the unknown JSON properties of decoded models and writes them back when encoding them. */
+  "preserve-unknown-properties"?: boolean;
+}
+
+const EmitterOptionsSchema: JSONSchemaType<GoEmitterOptions> = {
+  type: "object",
+  additionalProperties: false,
+  properties: {
+    "unknown-type": { type: "string", enum: ["raw", "any"], nullable: true, default: "raw" },
+    "type-aliases": { type: "boolean", nullable: true, default: false },
+    "required-properties": { type: "string", enum: ["ignore", "enforce"], nullable: true, default: "ignore" },
+    "unknown-properties": { type: "string", enum: ["ignore", "warn", "strict"], nullable: true, default: "ignore" },
+    "preserve-unknown-properties": { type: "boolean", nullable: true, default: false },
+  },
+  required: [],
+};
 
 export const $lib = createTypeSpecLibrary({
   name: "go-emitter",
-  diagnostics: {},
+  diagnostics: {
+    "duplicate-property": {
+      severity: "error",
+      messages: {
+        default: paramMessage`Properties ${"other"} and ${"name"} of ${"model"} are both emitted as ${"emittedName"}.`,
+      },
+    },
+    "duplicate-type-name": {
+      severity: "error",
+      messages: {
+        default: paramMessage`Anonymous type ${"name"} is named like another type of its package, rename one of them.`,
+      },
+    },
+    "reserved-name": {
+      severity: "error",
+      messages: {
+        default: paramMessage`${"name"} is emitted as ${"goName"}, which is declared by the helpers generated in its package.`,
+      },
+    },
+    "unsupported-default": {
+      severity: "warning",
+      messages: {
+        default: paramMessage`Default value of property ${"name"} of ${"model"} can't be expressed in Go, it is ignored.`,
+      },
+    },
+  },
+  emitter: {
+    options: EmitterOptionsSchema,
+  },
 });
 
 export const { reportDiagnostic, createDiagnostic } = $lib;
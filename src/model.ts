@@ -1,11 +1,25 @@
-import { pascalCase } from "change-case";
-import { ConstantValue, Optional, stripIndent, valueToGo } from "./common.js";
+import { BuiltInSymbol, SerializationFunctions } from "./built-in.js";
+import { ConstantValue, Constraints, Encoding, formatDoc, Optional, stripIndent, valueToGo } from "./common.js";
+import { ScalarSymbol } from "./scalar.js";
+import { UnknownPropertiesMode } from "./lib.js";
 import { BaseSymbol } from "./symbol.js";
+import { PropertyDefault } from "./defaults.js";
+import { holdsObjects, renderNestedUnknown } from "./unknown.js";
+import { TupleSymbol } from "./tuple.js";
 import { TypeUnionSymbol, UnionSymbol } from "./union.js";
+import {
+  getApplicableConstraints,
+  getPatternName,
+  indent,
+  isValidated,
+  renderConstraintChecks,
+  renderNestedValidation,
+  renderPattern,
+} from "./validation.js";
 
 export interface TypeTemplateParameter {
   kind: "type";
-  symbol: BaseSymbol;
+  type: PropertyType;
 }
 
 export interface ValueTemplateParameter {
@@ -18,6 +32,7 @@ export type TemplateParameter = TypeTemplateParameter | ValueTemplateParameter;
 export interface ModelPropertyType {
   kind: "model";
   type: BaseSymbol;
+  encoding?: Optional<Encoding>;
 }
 
 export interface ConstantPropertyType {
@@ -32,7 +47,23 @@ export interface TemplateInstancePropertyType {
   args: TemplateParameter[];
 }
 
-export type PropertyType = ModelPropertyType | ConstantPropertyType | TemplateInstancePropertyType;
+export interface TypeParameterPropertyType {
+  kind: "type_parameter";
+  name: string;
+}
+
+/* Values which can be null, like the elements of (string | null)[], held through a pointer. */
+export interface NullablePropertyType {
+  kind: "nullable";
+  type: PropertyType;
+}
+
+export type PropertyType =
+  | ModelPropertyType
+  | ConstantPropertyType
+  | TemplateInstancePropertyType
+  | TypeParameterPropertyType
+  | NullablePropertyType;
 
 export interface ModelPropertyDef {
   name: string;
@@ -42,34 +73,83 @@ export interface ModelPropertyDef {
   type: PropertyType;
   optional: boolean;
   nullable: boolean;
+  /* Set on required properties held through a pointer to break a reference cycle. */
+  indirect?: boolean;
+  constraints?: Constraints;
+  default?: PropertyDefault;
+}
+
+function getTemplateArgType(type: TemplateInstancePropertyType): PropertyType {
+  const arg = type.args[0];
+  if (arg.kind !== "type") {
+    throw new Error(`Unsupported value argument for template instance: ${type.template.name}`);
+  }
+  return arg.type;
 }
 
 function renderTemplateInstance(type: TemplateInstancePropertyType): string {
+  const args = type.args.map((a) => (a.kind === "type" ? renderType(a.type) : valueToGo(a.value)));
+  if (type.template.kind === "model") {
+    return `${type.template.goName}[${args.join(", ")}]`;
+  }
+  const arg = args[0];
   if (type.template.name === "Array") {
-    return `[]${type.args[0].kind === "type" ? type.args[0].symbol.goName : valueToGo(type.args[0].value)}`;
+    return `[]${arg}`;
+  } else if (type.template.name === "Record") {
+    return `map[string]${arg}`;
   }
   throw new Error(`Unsupported template instance: ${type.template.name}`);
 }
 
+function getTemplateHelperSuffix(type: TemplateInstancePropertyType): string {
+  if (type.template.name === "Array") {
+    return "Slice";
+  } else if (type.template.name === "Record") {
+    return "Map";
+  }
+  throw new Error(`Unsupported template instance: ${type.template.name}`);
+}
+
+export function getReferencedSymbols(type: PropertyType): BaseSymbol[] {
+  if (type.kind === "template_instance") {
+    return type.args.flatMap((a) => (a.kind === "type" ? getReferencedSymbols(a.type) : []));
+  } else if (type.kind === "type_parameter") {
+    return [];
+  } else if (type.kind === "nullable") {
+    return getReferencedSymbols(type.type);
+  }
+  return [type.type];
+}
+
+function isUnknown(type: PropertyType): boolean {
+  return type.kind === "model" && type.type.kind === "built-in" && type.type.name === "unknown";
+}
+
 function isTypeUnion(type: PropertyType): type is ModelPropertyType {
   return type.kind === "model" && type.type.kind === "type_union";
 }
 
+export function renderType(type: PropertyType): string {
+  if (type.kind === "model") {
+    return type.type.goName;
+  } else if (type.kind === "constant") {
+    return type.type.goName;
+  } else if (type.kind === "type_parameter") {
+    return type.name;
+  } else if (type.kind === "nullable") {
+    return `*${renderType(type.type)}`;
+  } else {
+    return renderTemplateInstance(type);
+  }
+}
+
 function renderPropertyType(property: ModelPropertyDef): string {
-  const { type, optional, nullable } = property;
-  const innerType = (() => {
-    if (type.kind === "model") {
-      return type.type.goName;
-    } else if (type.kind === "constant") {
-      return type.type.goName;
-    } else {
-      return renderTemplateInstance(type);
-    }
-  })();
+  const { type, optional, nullable, indirect } = property;
+  const innerType = renderType(type);
   if (nullable) {
     return `Nullable[${innerType}]`;
   }
-  if (optional) {
+  if (optional || indirect) {
     return `*${innerType}`;
   }
   return innerType;
@@ -83,36 +163,161 @@ export function renderValue(type: PropertyType): string {
   }
 }
 
+function getSerializationFunctions(type: PropertyType): Optional<SerializationFunctions> {
+  if (type.kind !== "model") {
+    return undefined;
+  } else if (type.type.kind === "scalar") {
+    return (type.type as ScalarSymbol).getSerializationFunctions(type.encoding);
+  } else if (type.type.kind !== "built-in") {
+    return undefined;
+  }
+  return (type.type as BuiltInSymbol).getSerializationFunctions(type.encoding);
+}
+
+/* Renders an expression serializing value, undefined when the value can be passed to json.Marshal as is. */
+export function renderSerializeExpression(type: PropertyType, value: string): Optional<string> {
+  if (type.kind === "template_instance" && type.template.kind === "model") {
+    /* Generic models have their own MarshalJSON. */
+    return undefined;
+  } else if (type.kind === "template_instance") {
+    const serializer = renderSerializer(getTemplateArgType(type));
+    return serializer !== undefined
+      ? `serialize${getTemplateHelperSuffix(type)}(${value}, ${serializer})`
+      : undefined;
+  } else if (type.kind === "nullable") {
+    const serializer = renderSerializer(type.type);
+    return serializer !== undefined ? `serializePointer(${value}, ${serializer})` : undefined;
+  }
+  const serializationFunctions = getSerializationFunctions(type);
+  return serializationFunctions !== undefined ? `${serializationFunctions.serializeFunction}(${value})` : undefined;
+}
+
+/* Renders a function of the form func(T) U serializing values of the given type. */
+function renderSerializer(type: PropertyType): Optional<string> {
+  if (type.kind !== "template_instance" && type.kind !== "nullable") {
+    return getSerializationFunctions(type)?.serializeFunction;
+  }
+  const expression = renderSerializeExpression(type, "v");
+  if (expression === undefined) {
+    return undefined;
+  }
+  return `func(v ${renderType(type)}) interface{} { return ${expression} }`;
+}
+
+/* Renders a call deserializing data into target, undefined when json.Unmarshal can be used as is. */
+export function renderDeserializeCall(type: PropertyType, data: string, target: string): Optional<string> {
+  if (type.kind === "template_instance" && type.template.kind === "model") {
+    return undefined;
+  } else if (type.kind === "template_instance") {
+    const deserializer = renderDeserializer(getTemplateArgType(type));
+    return deserializer !== undefined
+      ? `unmarshal${getTemplateHelperSuffix(type)}(${data}, ${target}, ${deserializer})`
+      : undefined;
+  } else if (type.kind === "nullable") {
+    const deserializer = renderDeserializer(type.type);
+    return deserializer !== undefined ? `unmarshalPointer(${data}, ${target}, ${deserializer})` : undefined;
+  }
+  const deserializer = renderDeserializer(type);
+  return deserializer !== undefined ? `${deserializer}(${data}, ${target})` : undefined;
+}
+
+/* Renders a function of the form func([]byte, *T) error deserializing values of the given type. */
+function renderDeserializer(type: PropertyType): Optional<string> {
+  if (isTypeUnion(type)) {
+    /* Interfaces can't be decoded by json.Unmarshal, they need the Unmarshal function of the union. */
+    return `unmarshalWith(Unmarshal${type.type.goName})`;
+  } else if (type.kind !== "template_instance" && type.kind !== "nullable") {
+    return getSerializationFunctions(type)?.deserializeFunction;
+  }
+  const call = renderDeserializeCall(type, "data", "v");
+  if (call === undefined) {
+    return undefined;
+  }
+  return `func(data []byte, v *${renderType(type)}) error { return ${call} }`;
+}
+
+/* Returns whether values of the type can't go through json.Marshal and json.Unmarshal as is. */
+export function needsCustomSerialization(type: PropertyType): boolean {
+  return renderSerializer(type) !== undefined || renderDeserializer(type) !== undefined;
+}
+
+/* Built-ins validated while decoding, their errors are reported along with the name of the property. */
+const validatedTypes = ["url"];
+
+export function wrapsErrors(property: ModelPropertyDef): boolean {
+  /* Scalars are decoded as the built-in they extend, whose errors are wrapped the same way. */
+  return getReferencedSymbols(property.type)
+    .map((s) => (s.kind === "scalar" ? (s as ScalarSymbol).getBuiltIn() : s))
+    .some((s) => s.kind === "built-in" && validatedTypes.includes(s.name));
+}
+
 function renderSerializationValue(property: ModelPropertyDef): string {
   if (property.type.kind === "constant") {
     return valueToGo(property.type.value);
-  } else if ((property.type.kind === "model") && (property.type.type as any).serializeFunction) {
-    return `${(property.type.type as any).serializeFunction}(m.${property.goName})`;
-  } else {
+  }
+  const serializer = renderSerializer(property.type);
+  if (serializer === undefined) {
     return `m.${property.goName}`;
   }
+  if (property.nullable) {
+    return `serializeNullable(m.${property.goName}, ${serializer})`;
+  } else if (property.optional) {
+    return renderSerializeExpression(property.type, `*m.${property.goName}`)!;
+  }
+  return renderSerializeExpression(property.type, `m.${property.goName}`)!;
 }
 
-function renderDeserializationFunction(property: ModelPropertyDef): string {
-  if ((property.type.kind === "model") && (property.type.type as any).deserializeFunction) {
-    return `${(property.type.type as any).deserializeFunction}`;
-  } else {
-    return "json.Unmarshal";
+function renderDeserializationCall(property: ModelPropertyDef): string {
+  const deserializer = renderDeserializer(property.type);
+  if (deserializer === undefined) {
+    return `json.Unmarshal(v, &m.${property.goName})`;
+  }
+  if (property.nullable) {
+    return `unmarshalNullable(v, &m.${property.goName}, ${deserializer})`;
+  } else if (property.optional || property.indirect) {
+    return `unmarshalPointer(v, &m.${property.goName}, ${deserializer})`;
   }
+  return renderDeserializeCall(property.type, "v", `&m.${property.goName}`)!;
 }
 
 export class ModelSymbol implements BaseSymbol {
   public readonly kind: "model" = "model";
   private readonly properties: ModelPropertyDef[] = [];
+  /* Type of the values of the keys not declared as properties, set for models spreading or being a Record. */
+  public additionalProperties: Optional<PropertyType> = undefined;
+  /* Set when decoding fails on a missing required property instead of leaving its zero value. */
+  public enforceRequired: boolean = false;
+  /* How decoding handles JSON properties matching no declared property, see collectsUnknown. */
+  public unknownProperties: UnknownPropertiesMode = "ignore";
+  /* Set when some model of the package tracks unknown properties, the values they hold then report theirs too. */
+  public collectsUnknown: boolean = false;
+  /* Set when the JSON properties matching no declared property are kept, and written back when encoding. */
+  public preservesUnknown: boolean = false;
 
   public constructor(
     public name: string,
     public namespace: Optional<string>,
     public goName: string,
     public doc: Optional<string>,
-    public parent: Optional<ModelSymbol>,
+    private readonly resolveParent: () => Optional<ModelSymbol>,
+    public typeParameters: string[] = [],
   ) {}
 
+  /* Resolved lazily, base models can be declared after the models extending them. */
+  get parent(): Optional<ModelSymbol> {
+    return this.resolveParent();
+  }
+
+  /* Returns the required properties holding their value in the struct, the ones which can make it infinitely large. */
+  getValueProperties(): ModelPropertyDef[] {
+    return this.properties.filter((p) => !p.optional && !p.nullable && p.type.kind !== "constant");
+  }
+
+  /* The type as used by receivers, with its type parameters for generic models. */
+  private get receiverType(): string {
+    return this.typeParameters.length > 0 ? `${this.goName}[${this.typeParameters.join(", ")}]` : this.goName;
+  }
+
   addProperty(property: ModelPropertyDef) {
     const isUnion = (type: BaseSymbol): type is UnionSymbol =>
       type.kind === "value_union" || type.kind === "type_union";
@@ -124,6 +329,18 @@ export class ModelSymbol implements BaseSymbol {
     this.properties.push(property);
   }
 
+  /* Returns a property other than the given one, inherited or not, emitted under the same Go or JSON name. */
+  findConflictingProperty(property: ModelPropertyDef): Optional<ModelPropertyDef> {
+    return this.getAllProperties().find(
+      (p) => p.name !== property.name && (p.goName === property.goName || p.jsonName === property.jsonName),
+    );
+  }
+
+  /* The additional properties of the model, declared on itself or inherited from its parent. */
+  getAdditionalProperties(): Optional<PropertyType> {
+    return this.additionalProperties ?? this.parent?.getAdditionalProperties();
+  }
+
   public getAllProperties(): ModelPropertyDef[] {
     const parentProperties = this.parent !== undefined ? this.parent.getAllProperties() : [];
     const mergedProperties = new Map<string, ModelPropertyDef>();
@@ -141,65 +358,180 @@ export class ModelSymbol implements BaseSymbol {
 
   emit(): string {
     const allProperties = this.getAllProperties();
+    const additionalProperties = this.getAdditionalProperties();
+    const declaredNames = [...new Set(allProperties.map((p) => `"${p.jsonName}"`))].join(", ");
+    /* The additional properties of a model already hold the keys matching no property. */
+    const preservesUnknown = this.preservesUnknown && additionalProperties === undefined;
+    const requiredProperties = this.enforceRequired
+      ? allProperties.filter((p) => p.type.kind !== "constant" && !p.optional && !p.nullable)
+      : [];
+    /* Optional and nullable properties are only added to the object when they are set. */
+    const requiredEntries = allProperties
+      .filter((m) => !m.optional && !m.nullable)
+      .map(
+        (m) => `
+                    "${m.jsonName}": ${renderSerializationValue(m)},`,
+      )
+      .join("");
     return stripIndent`
-            ${this.doc !== undefined ? `// ${this.goName} ${this.doc}"}` : ""}
-            type ${this.goName} struct {${
+            ${this.doc !== undefined ? formatDoc(this.goName, this.doc, "            ") : ""}
+            type ${this.goName}${
+              this.typeParameters.length > 0 ? `[${this.typeParameters.map((p) => `${p} any`).join(", ")}]` : ""
+            } struct {${
               this.parent !== undefined
                 ? `
                 ${this.parent.goName}`
                 : ""
             }${this.properties
               .filter((m) => m.type.kind !== "constant")
-              .map((m) =>
-                m.doc !== undefined
-                  ? `
-                // ${m.goName} ${m.doc}`
-                  : "" +
-                    `
+              .map(
+                (m) =>
+                  (m.doc !== undefined
+                    ? `
+                ${formatDoc(m.goName, m.doc, "                ")}`
+                    : "") +
+                  `
                 ${m.goName} ${renderPropertyType(m)}`,
               )
-              .join("")}
+              .join("")}${
+              this.additionalProperties !== undefined
+                ? `
+                AdditionalProperties map[string]${renderType(this.additionalProperties)}`
+                : ""
+            }${
+              this.unknownProperties === "warn"
+                ? `
+                unknownProperties []string`
+                : ""
+            }${
+              preservesUnknown
+                ? `
+                unrecognized map[string]json.RawMessage`
+                : ""
+            }
             }${this.properties
               .filter((m) => m.type.kind === "constant")
               .map(
                 (m) => `
 
-            func (m ${this.goName}) ${m.goName}() ${renderPropertyType(m)} {
+            func (m ${this.receiverType}) ${m.goName}() ${renderPropertyType(m)} {
                 return ${renderValue(m.type)}
             }`,
               )
-              .join("")}
+              .join("")}${this.properties
+              .filter((m) => isUnknown(m.type))
+              .map(
+                (m) => `
+
+            // Decode${m.goName} decodes the value of ${m.goName} into v.
+            func (m ${this.receiverType}) Decode${m.goName}(v interface{}) error {
+                return decodeUnknownInternal(m.${m.goName}, v)
+            }`,
+              )
+              .join("")}${
+              this.unknownProperties === "warn"
+                ? `
+
+            // UnknownProperties returns the paths of the JSON properties unknown to ${this.goName} or its values when decoded.
+            func (m ${this.receiverType}) UnknownProperties() []string {
+                return m.unknownProperties
+            }`
+                : ""
+            }${this.emitDefaults()}
 
-            func (m *${this.goName})  UnmarshalJSON(data []byte) error {
+            func (m *${this.receiverType})  UnmarshalJSON(data []byte) error {
                 var rawMsg map[string]json.RawMessage
                 if err := json.Unmarshal(data, &rawMsg); err != nil {
                     return err
+                }${
+                  requiredProperties.length > 0
+                    ? `
+                var missing []string`
+                    : ""
                 }${allProperties
                   .filter((m) => m.type.kind !== "constant")
                   .map(
                     (m) => `
-                if v, ok := rawMsg["${m.name}"]; ok {${isTypeUnion(m.type) ? `
-                    value, err := Unmarshal${pascalCase(m.goName)}(v)
+                if v, ok := rawMsg["${m.jsonName}"]; ok {${isTypeUnion(m.type) ? `
+                    value, err := Unmarshal${m.type.type.goName}(v)
                     if err != nil {
                         return err
                     }
-                    ${m.nullable ? `m.${m.goName} = SetNullable(value)` : `m.${m.goName} = value`}` : `
-                    if err := ${renderDeserializationFunction(m)}(v, &m.${m.goName}); err != nil {
-                        return err
+                    ${m.nullable ? `m.${m.goName} = SetNullable(value)` : m.optional ? `m.${m.goName} = &value` : `m.${m.goName} = value`}` : `
+                    if err := ${renderDeserializationCall(m)}; err != nil {
+                        return ${wrapsErrors(m) ? `fmt.Errorf("${m.jsonName}: %w", err)` : "err"}
                     }`}
-                }`)
-                  .join("")}
+                }${requiredProperties.includes(m) ? ` else {
+                    missing = append(missing, "${m.jsonName}")
+                }` : ""}`)
+                  .join("")}${
+                  requiredProperties.length > 0
+                    ? `
+                if len(missing) > 0 {
+                    return &MissingPropertiesError{Model: "${this.goName}", Properties: missing}
+                }`
+                    : ""
+                }${
+                  additionalProperties !== undefined
+                    ? `
+                for key, v := range rawMsg {${
+                  declaredNames !== ""
+                    ? `
+                    switch key {
+                    case ${declaredNames}:
+                        continue
+                    }`
+                    : ""
+                }
+                    var value ${renderType(additionalProperties)}
+                    if err := ${renderDeserializeCall(additionalProperties, "v", "&value") ?? "json.Unmarshal(v, &value)"}; err != nil {
+                        return fmt.Errorf("%s: %w", key, err)
+                    }
+                    if m.AdditionalProperties == nil {
+                        m.AdditionalProperties = map[string]${renderType(additionalProperties)}{}
+                    }
+                    m.AdditionalProperties[key] = value
+                }`
+                    : ""
+                }${
+                  preservesUnknown
+                    ? `
+                m.unrecognized = nil
+                for key, v := range rawMsg {${
+                  declaredNames !== ""
+                    ? `
+                    switch key {
+                    case ${declaredNames}:
+                        continue
+                    }`
+                    : ""
+                }
+                    if m.unrecognized == nil {
+                        m.unrecognized = map[string]json.RawMessage{}
+                    }
+                    m.unrecognized[key] = v
+                }`
+                    : ""
+                }${
+                  this.unknownProperties === "strict"
+                    ? `
+                if unknown := findUnknownProperties(data, m); len(unknown) > 0 {
+                    return &UnknownPropertiesError{Model: "${this.goName}", Paths: unknown}
+                }`
+                    : this.unknownProperties === "warn"
+                      ? `
+                m.unknownProperties = findUnknownProperties(data, m)`
+                      : ""
+                }
                 return nil
             }
 
-            func (m ${this.goName}) MarshalJSON() ([]byte, error) {
-                obj := map[string]interface{}{${allProperties
-                  .filter((m) => !m.optional && !m.nullable)
-                  .map(
-                    (m) => `
-                    "${m.jsonName}": ${renderSerializationValue(m)},`,
-                  )
-                  .join("")}
+            func (m ${this.receiverType}) MarshalJSON() ([]byte, error) {
+                obj := map[string]interface{}{${requiredEntries}${
+                  requiredEntries !== ""
+                    ? `
+                }`
+                    : "}"
                 }
                 ${allProperties.filter((m) => m.nullable).map(
                   (m) => `
@@ -207,16 +539,327 @@ export class ModelSymbol implements BaseSymbol {
                     obj["${m.jsonName}"] = ${renderSerializationValue(m)}
                 }`).join("")}
                 ${allProperties
-                  .filter((m) => m.optional)
+                  .filter((m) => m.optional && !m.nullable)
                   .map(
                     (m) => `
                 if m.${m.goName} != nil {
                     obj["${m.jsonName}"] = ${renderSerializationValue(m)}
                 }`,
                   )
-                  .join("")}
+                  .join("")}${
+                  additionalProperties !== undefined
+                    ? `
+                for key, value := range m.AdditionalProperties {${
+                  declaredNames !== ""
+                    ? `
+                    switch key {
+                    case ${declaredNames}:
+                        return nil, fmt.Errorf("additional property %q of ${this.goName} conflicts with a declared property", key)
+                    }`
+                    : ""
+                }
+                    obj[key] = ${renderSerializeExpression(additionalProperties, "value") ?? "value"}
+                }`
+                    : ""
+                }${
+                  preservesUnknown
+                    ? `
+                for key, v := range m.unrecognized {
+                    obj[key] = v
+                }`
+                    : ""
+                }
 
                 return json.Marshal(obj)
+            }${this.collectsUnknown ? `
+
+${this.emitUnknownCollector()}` : ""}`;
+  }
+
+  /* Emits the defaults declared on the properties of the model, with accessors falling back to them. */
+  private emitDefaults(): string {
+    const declared = this.properties.filter((p) => p.default !== undefined);
+    const defaulted = this.getAllProperties().filter((p) => p.default !== undefined);
+    if (defaulted.length === 0) {
+      return "";
+    }
+    const unset = defaulted.filter((p) => p.optional || p.nullable);
+    const typeParameters =
+      this.typeParameters.length > 0 ? `[${this.typeParameters.map((p) => `${p} any`).join(", ")}]` : "";
+    const lines = [
+      ...declared.map(
+        (p) => `
+// ${p.default!.name} is the default value of ${p.goName}.
+${p.default!.constant ? `const ${p.default!.name} ${renderType(p.type)}` : `var ${p.default!.name}`} = ${p.default!.value}`,
+      ),
+      ...declared
+        .filter((p) => p.optional && !p.nullable)
+        .map(
+          (p) => `
+// Get${p.goName} returns the value of ${p.goName}, or its default when unset.
+func (m ${this.receiverType}) Get${p.goName}() ${renderType(p.type)} {
+    if m.${p.goName} != nil {
+        return *m.${p.goName}
+    }
+    return ${p.default!.name}
+}`,
+        ),
+      `
+// New${this.goName} returns a new ${this.goName} holding the default values of its properties.
+func New${this.goName}${typeParameters}() ${this.receiverType} {
+    var m ${this.receiverType}${defaulted
+      .filter((p) => !p.optional && !p.nullable)
+      .map(
+        (p) => `
+    m.${p.goName} = ${p.indirect ? `Ptr(${p.default!.name})` : p.default!.name}`,
+      )
+      .join("")}${
+      unset.length > 0
+        ? `
+    m.ApplyDefaults()`
+        : ""
+    }
+    return m
+}`,
+      ...(unset.length > 0
+        ? [
+            `
+// ApplyDefaults sets the optional properties left unset to their default value.
+func (m *${this.receiverType}) ApplyDefaults() {${unset
+              .map((p) =>
+                p.nullable
+                  ? `
+    if !m.${p.goName}.IsSet() {
+        m.${p.goName} = SetNullable(${p.default!.name})
+    }`
+                  : `
+    if m.${p.goName} == nil {
+        m.${p.goName} = Ptr(${p.default!.name})
+    }`,
+              )
+              .join("")}
+}`,
+          ]
+        : []),
+    ];
+    return `\n${indent(lines, "            ")}`;
+  }
+
+  /* Emits collectUnknown, reporting the keys of data matching no property and recursing into the values held. */
+  private emitUnknownCollector(): string {
+    const allProperties = this.getAllProperties();
+    const additionalProperties = this.getAdditionalProperties();
+    const declaredNames = [...new Set(allProperties.map((p) => `"${p.jsonName}"`))].join(", ");
+    const statements = allProperties
+      .filter((p) => p.type.kind !== "constant" && holdsObjects(p.type))
+      .map((p) => {
+        const jsonName = JSON.stringify(p.jsonName);
+        const nested = renderNestedUnknown(p.type, getPropertyValue(p), "v", `joinPath(path, ${jsonName})`);
+        return `if v, ok := rawMsg[${jsonName}]; ok {
+${indent(guardProperty(p, nested))}
+}`;
+      });
+    if (additionalProperties !== undefined && holdsObjects(additionalProperties)) {
+      statements.push(`for key, e0 := range m.AdditionalProperties {
+    if v, ok := rawMsg[key]; ok {
+${indent(renderNestedUnknown(additionalProperties, "e0", "v", "joinPath(path, key)", 1), "        ")}
+    }
+}`);
+    }
+    /* The keys of models with additional properties are all known. */
+    const checksKeys = additionalProperties === undefined;
+    return stripIndent`
+            func (m ${this.receiverType}) collectUnknown(data []byte, path string, unknown *[]string) {${
+              checksKeys || statements.length > 0
+                ? `
+                rawMsg := rawEntries(data)`
+                : ""
+            }${
+              checksKeys
+                ? `
+                for key := range rawMsg {${
+                  declaredNames !== ""
+                    ? `
+                    switch key {
+                    case ${declaredNames}:
+                    default:
+                        *unknown = append(*unknown, joinPath(path, key))
+                    }`
+                    : `
+                    *unknown = append(*unknown, joinPath(path, key))`
+                }
+                }`
+                : ""
+            }
+${indent(statements, "                ")}
+            }`;
+  }
+
+  /* Emits Validate, checking the constraints of the properties and recursing into the values they hold. */
+  emitValidation(): string {
+    const properties = this.getAllProperties().filter((p) => p.type.kind !== "constant");
+    const additionalProperties = this.getAdditionalProperties();
+    const statements = properties.flatMap((p) => renderPropertyValidation(this, p));
+    if (additionalProperties !== undefined && isValidated(additionalProperties)) {
+      statements.push(`for k0, e0 := range m.AdditionalProperties {
+${indent(renderNestedValidation(additionalProperties, "e0", "keyPath(path, k0)", 1))}
+}`);
+    }
+    const patterns = properties
+      .filter((p) => getApplicableConstraints(p.constraints, p.type).pattern !== undefined)
+      .map((p) => renderPattern(getPatternName(this.goName, p.goName), p.constraints!.pattern!));
+    return stripIndent`${patterns
+      .map(
+        (p) => `
+            ${p}`,
+      )
+      .join("")}${patterns.length > 0 ? "\n" : ""}
+            // Validate checks the constraints of ${this.goName} and of the values it holds, the error lists every violation.
+            func (m ${this.receiverType}) Validate() error {
+                var errs violations
+                m.validate(&errs, "")
+                return errs.err()
+            }
+
+            func (m ${this.receiverType}) validate(errs *violations, path string) {
+${indent(statements, "                ")}
             }`;
   }
 }
+
+/* Renders the statements validating a property. */
+function renderPropertyValidation(model: ModelSymbol, property: ModelPropertyDef): string[] {
+  const { goName, jsonName, type } = property;
+  const path = `joinPath(path, ${JSON.stringify(jsonName)})`;
+  const value = getPropertyValue(property);
+  const statements = [
+    ...renderConstraintChecks(
+      getApplicableConstraints(property.constraints, type),
+      type,
+      value,
+      path,
+      getPatternName(model.goName, goName),
+    ),
+    ...renderNestedValidation(type, value, path),
+  ];
+  return guardProperty(property, statements);
+}
+
+/* The value of the property in m, dereferenced for the properties held through a pointer. */
+function getPropertyValue(property: ModelPropertyDef): string {
+  const { goName, nullable, optional, indirect } = property;
+  return nullable ? `*m.${goName}.value` : optional || indirect ? `*m.${goName}` : `m.${goName}`;
+}
+
+/* Nests the statements using the value of an optional or nullable property in a check that it is set. */
+function guardProperty(property: ModelPropertyDef, statements: string[]): string[] {
+  const { goName, nullable, optional, indirect } = property;
+  if (statements.length === 0 || (!nullable && !optional && !indirect)) {
+    return statements;
+  }
+  return [
+    `if m.${goName}${nullable ? ".value" : ""} != nil {
+${indent(statements)}
+}`,
+  ];
+}
+
+/* A struct directly containing another one, through a property which can be made a pointer or through an embedded
+   parent or a tuple item which can't. */
+interface Containment {
+  target: ModelSymbol | TupleSymbol;
+  property?: ModelPropertyDef;
+}
+
+/* Returns the structs a value of the type holds, generic instances hold the arguments of the type parameters their
+ * template holds by value, like Envelope<Node, string> holds Node when Envelope has a property typed T. */
+function getValueStructs(
+  type: PropertyType,
+  args: Map<string, PropertyType> = new Map(),
+  templates: Set<ModelSymbol> = new Set(),
+): (ModelSymbol | TupleSymbol)[] {
+  if (type.kind === "type_parameter") {
+    const arg = args.get(type.name);
+    return arg !== undefined ? getValueStructs(arg) : [];
+  } else if (type.kind === "model") {
+    return type.type.kind === "model" || type.type.kind === "tuple" ? [type.type as ModelSymbol | TupleSymbol] : [];
+  } else if (type.kind === "template_instance" && type.template.kind === "model") {
+    const template = type.template as ModelSymbol;
+    /* Go rejects generic types holding an instance of themselves, this only keeps the walk from looping. */
+    if (templates.has(template)) {
+      return [];
+    }
+    const bound = new Map(
+      template.typeParameters.flatMap((name, i): [string, PropertyType][] => {
+        const arg = type.args[i];
+        if (arg?.kind !== "type") {
+          return [];
+        }
+        return [[name, arg.type.kind === "type_parameter" ? (args.get(arg.type.name) ?? arg.type) : arg.type]];
+      }),
+    );
+    return template
+      .getAllProperties()
+      .filter((p) => !p.optional && !p.nullable)
+      .flatMap((p) => getValueStructs(p.type, bound, new Set([...templates, template])));
+  }
+  return [];
+}
+
+function getContainments(symbol: ModelSymbol | TupleSymbol): Containment[] {
+  if (symbol.kind === "tuple") {
+    return symbol.items.flatMap((item) => getValueStructs(item)).map((target) => ({ target }));
+  }
+  const parent = symbol.parent;
+  return [
+    ...(parent !== undefined ? [{ target: parent }] : []),
+    ...symbol
+      .getValueProperties()
+      .filter((p) => !p.indirect)
+      .flatMap((property) => getValueStructs(property.type).map((target) => ({ target, property }))),
+  ];
+}
+
+/* Go structs can't contain themselves, required properties closing a cycle of structs are held through a pointer. */
+export function breakReferenceCycles(symbols: (ModelSymbol | TupleSymbol)[]) {
+  const byName = (a: ModelSymbol | TupleSymbol, b: ModelSymbol | TupleSymbol) => a.goName.localeCompare(b.goName);
+  /* Returns whether a cycle was broken, the ones left behind it are found by another walk. */
+  const breakCycles = (): boolean => {
+    const visited = new Set<ModelSymbol | TupleSymbol>();
+    /* The symbols being visited, containments[i] is how stack[i] contains stack[i + 1]. */
+    const stack: (ModelSymbol | TupleSymbol)[] = [];
+    const containments: Containment[] = [];
+    let broken = false;
+    const visit = (symbol: ModelSymbol | TupleSymbol) => {
+      visited.add(symbol);
+      stack.push(symbol);
+      for (const containment of getContainments(symbol)) {
+        const start = stack.indexOf(containment.target);
+        if (start !== -1) {
+          /* The last property closing the cycle becomes a pointer, parents and tuple items can't. */
+          const cycle = [...containments.slice(start), containment];
+          const property = cycle.reverse().find((c) => c.property !== undefined)?.property;
+          if (property !== undefined) {
+            property.indirect = true;
+            broken = true;
+          }
+        } else if (!visited.has(containment.target)) {
+          containments.push(containment);
+          visit(containment.target);
+          containments.pop();
+        }
+      }
+      stack.pop();
+    };
+    /* Sorted so the properties made pointers don't depend on the order the types were visited in. */
+    for (const symbol of [...symbols].sort(byName)) {
+      if (!visited.has(symbol)) {
+        visit(symbol);
+      }
+    }
+    return broken;
+  };
+  while (breakCycles()) {
+    /* Walks again until no cycle is left. */
+  }
+}
@@ -0,0 +1,130 @@
+import { BuiltInSymbol, SerializationFunctions } from "./built-in.js";
+import { Constraints, Encoding, formatDoc, Optional, stripIndent } from "./common.js";
+import { BaseSymbol } from "./symbol.js";
+import {
+  getApplicableConstraints,
+  getPatternName,
+  indent,
+  renderConstraintChecks,
+  renderPattern,
+} from "./validation.js";
+
+/* Go types of built-ins implementing json.Marshaler themselves, a named type doesn't inherit their methods. */
+const marshalerTypes = ["Decimal"];
+
+export class ScalarSymbol implements BaseSymbol {
+  public readonly kind: "scalar" = "scalar";
+  public base: Optional<BuiltInSymbol | ScalarSymbol> = undefined;
+  public constraints: Constraints = {};
+
+  public constructor(
+    public name: string,
+    public namespace: Optional<string>,
+    public goName: string,
+    public doc: Optional<string>,
+    public encoding: Optional<Encoding>,
+  ) {}
+
+  /* Returns the built-in scalar at the root of the extends chain. */
+  getBuiltIn(): BuiltInSymbol {
+    if (this.base === undefined) {
+      throw new Error(`Base of scalar ${this.name} not defined`);
+    }
+    return this.base.kind === "built-in" ? this.base : this.base.getBuiltIn();
+  }
+
+  /* Returns the encoding of the scalar, inherited from its base when not declared on the scalar itself. */
+  getEncoding(): Optional<Encoding> {
+    if (this.encoding !== undefined || this.base?.kind !== "scalar") {
+      return this.encoding;
+    }
+    return this.base.getEncoding();
+  }
+
+  /* The constraints declared on the scalar itself which can be checked on its Go type. */
+  getConstraints(): Constraints {
+    return getApplicableConstraints(this.constraints, { kind: "model", type: this });
+  }
+
+  /* Returns whether the scalar or one of its bases declares constraints. */
+  isValidated(): boolean {
+    return (
+      Object.values(this.getConstraints()).some((c) => c !== undefined) ||
+      (this.base?.kind === "scalar" && this.base.isValidated())
+    );
+  }
+
+  get include(): Optional<string> {
+    return this.getBuiltIn().include;
+  }
+
+  /* Returns helpers for an @encode applied on a property, the scalar's own encoding is handled by its JSON methods. */
+  getSerializationFunctions(encoding: Optional<Encoding>): Optional<SerializationFunctions> {
+    if (encoding === undefined) {
+      return undefined;
+    }
+    const builtIn = this.getBuiltIn();
+    const functions = builtIn.getSerializationFunctions(encoding);
+    if (functions === undefined) {
+      return undefined;
+    }
+    return {
+      serializeFunction: `func(v ${this.goName}) interface{} { return ${functions.serializeFunction}(${builtIn.goName}(v)) }`,
+      deserializeFunction: `func(data []byte, v *${this.goName}) error { return ${functions.deserializeFunction}(data, (*${builtIn.goName})(v)) }`,
+    };
+  }
+
+  emit(): string {
+    const builtIn = this.getBuiltIn();
+    const functions = builtIn.getSerializationFunctions(this.getEncoding());
+    return stripIndent`
+      ${this.doc !== undefined ? formatDoc(this.goName, this.doc, "      ") : ""}
+      type ${this.goName} ${builtIn.goName}${
+        functions !== undefined
+          ? `
+
+      func (s ${this.goName}) MarshalJSON() ([]byte, error) {
+        return json.Marshal(${functions.serializeFunction}(${builtIn.goName}(s)))
+      }
+
+      func (s *${this.goName}) UnmarshalJSON(data []byte) error {
+        return ${functions.deserializeFunction}(data, (*${builtIn.goName})(s))
+      }`
+          : marshalerTypes.includes(builtIn.goName)
+            ? `
+
+      func (s ${this.goName}) MarshalJSON() ([]byte, error) {
+        return json.Marshal(${builtIn.goName}(s))
+      }
+
+      func (s *${this.goName}) UnmarshalJSON(data []byte) error {
+        return json.Unmarshal(data, (*${builtIn.goName})(s))
+      }`
+            : ""
+      }`;
+  }
+
+  emitValidation(): Optional<string> {
+    if (!this.isValidated()) {
+      return undefined;
+    }
+    const constraints = this.getConstraints();
+    const patternName = getPatternName(this.goName);
+    const statements = [
+      ...(this.base?.kind === "scalar" && this.base.isValidated()
+        ? [`${this.base.goName}(s).validate(errs, path)`]
+        : []),
+      ...renderConstraintChecks(constraints, { kind: "model", type: this }, "s", "path", patternName),
+    ];
+    return stripIndent`${
+      constraints.pattern !== undefined
+        ? `
+      ${renderPattern(patternName, constraints.pattern)}
+`
+        : ""
+    }
+      func (s ${this.goName}) validate(errs *violations, path string) {
+${indent(statements, "        ")}
+      }`;
+  }
+}
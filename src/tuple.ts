@@ -0,0 +1,88 @@
+import { Optional, stripIndent } from "./common.js";
+import { PropertyType, renderDeserializeCall, renderSerializeExpression, renderType } from "./model.js";
+import { BaseSymbol } from "./symbol.js";
+import { holdsObjects, renderNestedUnknown } from "./unknown.js";
+import { indent, isValidated, renderNestedValidation } from "./validation.js";
+
+/* Nested tuples prefix the errors of their items with their own position. */
+function isTuple(type: PropertyType): boolean {
+  return type.kind === "model" && type.type.kind === "tuple";
+}
+
+export class TupleSymbol implements BaseSymbol {
+  public readonly kind: "tuple" = "tuple";
+  public items: PropertyType[] = [];
+  /* Set when the models of the package track unknown properties, see ModelSymbol. */
+  public collectsUnknown: boolean = false;
+
+  public constructor(
+    public name: string,
+    public namespace: Optional<string>,
+    public goName: string,
+    /* JSON name of the property holding the tuple, like bounds[0] for nested tuples, item errors are prefixed by it. */
+    public jsonName: string = "",
+  ) {}
+
+  emit(): string {
+    return stripIndent`
+      type ${this.goName} struct {${this.items
+        .map(
+          (item, i) => `
+        Item${i} ${renderType(item)}`,
+        )
+        .join("")}
+      }
+
+      func (t *${this.goName}) UnmarshalJSON(data []byte) error {
+        var items []json.RawMessage
+        if err := json.Unmarshal(data, &items); err != nil {
+          return err
+        }
+        if len(items) != ${this.items.length} {
+          return fmt.Errorf("expected ${this.items.length} items for ${this.goName} but got %d", len(items))
+        }${this.items
+          .map(
+            (item, i) => `
+        if err := ${renderDeserializeCall(item, `items[${i}]`, `&t.Item${i}`) ?? `json.Unmarshal(items[${i}], &t.Item${i})`}; err != nil {
+          return ${isTuple(item) ? "err" : `fmt.Errorf("${this.jsonName}[${i}]: %w", err)`}
+        }`,
+          )
+          .join("")}
+        return nil
+      }
+
+      func (t ${this.goName}) MarshalJSON() ([]byte, error) {
+        return json.Marshal([]interface{}{${this.items
+          .map((item, i) => renderSerializeExpression(item, `t.Item${i}`) ?? `t.Item${i}`)
+          .join(", ")}})
+      }${
+        this.collectsUnknown && this.items.some(holdsObjects)
+          ? `
+
+      func (t ${this.goName}) collectUnknown(data []byte, path string, unknown *[]string) {
+        items := rawItems(data)
+        if len(items) != ${this.items.length} {
+          return
+        }
+${indent(
+  this.items.flatMap((item, i) => renderNestedUnknown(item, `t.Item${i}`, `items[${i}]`, `indexPath(path, ${i})`)),
+  "        ",
+)}
+      }`
+          : ""
+      }`;
+  }
+
+  emitValidation(): Optional<string> {
+    if (!this.items.some(isValidated)) {
+      return undefined;
+    }
+    const statements = this.items.flatMap((item, i) =>
+      renderNestedValidation(item, `t.Item${i}`, `indexPath(path, ${i})`),
+    );
+    return stripIndent`
+      func (t ${this.goName}) validate(errs *violations, path string) {
+${indent(statements, "        ")}
+      }`;
+  }
+}
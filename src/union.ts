@@ -1,24 +1,27 @@
 import { camelCase, pascalCase } from "change-case";
-import { ConstantValue, Optional, stripIndent, valueToGo } from "./common.js";
+import { ConstantValue, formatDoc, Optional, stripIndent, valueToGo } from "./common.js";
 import { ModelPropertyDef, renderValue } from "./model.js";
 import { BaseSymbol } from "./symbol.js";
 import { integerTypes } from "./built-in.js";
+import { renderNestedUnknown } from "./unknown.js";
+import { indent, renderNestedValidation } from "./validation.js";
 
 function emitValueUnion(name: string, doc: Optional<string>, type: string, variants: ValueUnionVariant[]): string {
   const variantName = (v: ValueUnionVariant) => {
     return `${name}${v.goName}`;
   };
   return stripIndent`
-      ${doc !== undefined ? `// ${name} ${doc}` : ""}
+      ${doc !== undefined ? formatDoc(name, doc, "      ") : ""}
       type ${name} ${type}
 
       const (${variants
-        .map((v) =>
-          v.doc !== undefined
-            ? `
-        // ${variantName(v)} ${v.doc}`
-            : "" +
-              `
+        .map(
+          (v) =>
+            (v.doc !== undefined
+              ? `
+        ${formatDoc(variantName(v), v.doc, "        ")}`
+              : "") +
+            `
         ${variantName(v)} ${name} = ${valueToGo(v.value)}`,
         )
         .join("")}
@@ -36,7 +39,7 @@ function emitValueUnion(name: string, doc: Optional<string>, type: string, varia
 
 
       func (f ${name}) MarshalJSON() ([]byte, error) {
-        return json.Marshal(f)
+        return json.Marshal(${type}(f))
       }`;
 }
 
@@ -88,12 +91,12 @@ function emitDiscriminatedTypeUnion(
   variants: TypeUnionVariant[],
 ): string {
   return stripIndent`
-      ${doc !== undefined ? `// ${name} ${doc}` : ""}
+      ${doc !== undefined ? formatDoc(name, doc, "      ") : ""}
       type ${name} interface {
         ${discriminator.goName}() ${discriminator.type.goName}
       }
 
-      func Unmarshal${pascalCase(name)}(data []byte) (${name}, error) {
+      func Unmarshal${name}(data []byte) (${name}, error) {
         var typeCheck struct {
           ${discriminator.goName} ${discriminator.type.goName} \`json:"${discriminator.jsonName}"\`
         }
@@ -121,7 +124,7 @@ function emitDiscriminatedTypeUnion(
 
 function emitTypeUnion(name: string, doc: Optional<string>, variants: TypeUnionVariant[]): string {
   return stripIndent`${doc !== undefined ? `
-      // ${name} ${doc}` : ""}
+      ${formatDoc(name, doc, "      ")}` : ""}
       type ${name} interface {
         Type() string
       }
@@ -137,10 +140,10 @@ function emitTypeUnion(name: string, doc: Optional<string>, variants: TypeUnionV
       }
       `).join("")}
 
-      func Unmarshal${pascalCase(name)}(data []byte) (${name}, error) {
+      func Unmarshal${name}(data []byte) (${name}, error) {
         var err error
         ${variants.map(v => `
-        var ${camelCase(v.goName)} ${v.goName}
+        var ${camelCase(v.goName)} ${v.typeSymbol.goName}
         if err = json.Unmarshal(data, &${camelCase(v.goName)}); err == nil {
           return ${name}${pascalCase(v.goName)}{Value: ${camelCase(v.goName)}}, nil
         }
@@ -168,6 +171,8 @@ export class TypeUnionSymbol implements BaseSymbol {
   public readonly kind: "type_union" = "type_union";
   public readonly variants: TypeUnionVariant[] = [];
   public discriminator: Optional<DiscriminatorDef> = undefined;
+  /* Set when the models of the package track unknown properties, see ModelSymbol. */
+  public collectsUnknown: boolean = false;
 
   public constructor(
     public name: string,
@@ -178,11 +183,52 @@ export class TypeUnionSymbol implements BaseSymbol {
     public nullable: boolean,
   ) {}
 
+  /* Variants of discriminated unions are models validating themselves, the others are validated by their wrapper. */
+  emitValidation(): Optional<string> {
+    if (this.discriminator !== undefined) {
+      return undefined;
+    }
+    const validations = this.variants
+      .map((v) => ({
+        wrapper: `${this.goName}${pascalCase(v.goName)}`,
+        statements: renderNestedValidation({ kind: "model", type: v.typeSymbol }, "v.Value", "path"),
+      }))
+      .filter((v) => v.statements.length > 0)
+      .map(
+        (v) => `
+      func (v ${v.wrapper}) validate(errs *violations, path string) {
+${indent(v.statements, "        ")}
+      }`,
+      );
+    return validations.length > 0 ? stripIndent`${validations.join("\n")}` : undefined;
+  }
+
+  /* Variants of discriminated unions are models collecting their own unknown properties, the others use a wrapper. */
+  private emitUnknownCollectors(): string {
+    if (!this.collectsUnknown || this.discriminator !== undefined) {
+      return "";
+    }
+    return this.variants
+      .map((v) => ({
+        wrapper: `${this.goName}${pascalCase(v.goName)}`,
+        statements: renderNestedUnknown({ kind: "model", type: v.typeSymbol }, "v.Value", "data", "path"),
+      }))
+      .filter((v) => v.statements.length > 0)
+      .map(
+        (v) => `
+
+func (v ${v.wrapper}) collectUnknown(data []byte, path string, unknown *[]string) {
+${indent(v.statements)}
+}`,
+      )
+      .join("");
+  }
+
   emit(): string {
     if (this.discriminator === undefined) {
-      return emitTypeUnion(this.name, this.doc, this.variants);
+      return emitTypeUnion(this.goName, this.doc, this.variants) + this.emitUnknownCollectors();
     }
-    return emitDiscriminatedTypeUnion(this.name, this.doc, this.discriminator, this.variants);
+    return emitDiscriminatedTypeUnion(this.goName, this.doc, this.discriminator, this.variants);
   }
 }
 
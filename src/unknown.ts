@@ -0,0 +1,72 @@
+import { PropertyType } from "./model.js";
+import { TupleSymbol } from "./tuple.js";
+import { asReceiver, indent } from "./validation.js";
+
+/* Returns whether values of the type can hold JSON objects, the ones which can have unknown properties. */
+export function holdsObjects(type: PropertyType): boolean {
+  if (type.kind === "template_instance") {
+    return type.template.kind === "model" || type.args.some((a) => a.kind === "type" && holdsObjects(a.type));
+  } else if (type.kind === "nullable") {
+    return holdsObjects(type.type);
+  } else if (type.kind === "type_parameter") {
+    return true;
+  } else if (type.kind === "constant") {
+    return false;
+  }
+  switch (type.type.kind) {
+    case "model":
+    case "type_union":
+      return true;
+    case "tuple":
+      return (type.type as TupleSymbol).items.some(holdsObjects);
+    default:
+      return false;
+  }
+}
+
+/* Renders the statements collecting the unknown properties of value, decoded from data. */
+export function renderNestedUnknown(
+  type: PropertyType,
+  value: string,
+  data: string,
+  path: string,
+  depth: number = 0,
+): string[] {
+  if (!holdsObjects(type)) {
+    return [];
+  }
+  if (type.kind === "type_parameter" || (type.kind === "model" && type.type.kind === "type_union")) {
+    return [`collectUnknownValue(${data}, ${path}, unknown, ${value})`];
+  } else if (type.kind === "nullable") {
+    return [
+      `if ${value} != nil {
+${indent(renderNestedUnknown(type.type, `*${value}`, data, path, depth))}
+}`,
+    ];
+  } else if (type.kind === "template_instance" && type.template.kind !== "model") {
+    const arg = type.args[0];
+    if (arg.kind !== "type") {
+      return [];
+    }
+    if (type.template.name === "Record") {
+      const nested = renderNestedUnknown(arg.type, `e${depth}`, `r${depth}`, `keyPath(${path}, k${depth})`, depth + 1);
+      return [
+        `for k${depth}, r${depth} := range rawEntries(${data}) {
+    if e${depth}, ok := ${asReceiver(value)}[k${depth}]; ok {
+${indent(nested, "        ")}
+    }
+}`,
+      ];
+    }
+    const item = `${asReceiver(value)}[i${depth}]`;
+    const nested = renderNestedUnknown(arg.type, item, `r${depth}`, `indexPath(${path}, i${depth})`, depth + 1);
+    return [
+      `for i${depth}, r${depth} := range rawItems(${data}) {
+    if i${depth} < len(${value}) {
+${indent(nested, "        ")}
+    }
+}`,
+    ];
+  }
+  return [`${asReceiver(value)}.collectUnknown(${data}, ${path}, unknown)`];
+}
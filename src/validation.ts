@@ -0,0 +1,198 @@
+import { camelCase } from "change-case";
+import { getUnderlyingType, integerTypes, numericTypes } from "./built-in.js";
+import { Constraints, Optional } from "./common.js";
+import { PropertyType } from "./model.js";
+import { ScalarSymbol } from "./scalar.js";
+import { TupleSymbol } from "./tuple.js";
+
+/* Keeps the constraints which apply to the type, TypeSpec accepts some of them on types Go can't check them on. */
+export function getApplicableConstraints(constraints: Optional<Constraints>, type: PropertyType): Constraints {
+  const underlyingType =
+    type.kind === "template_instance" && type.template.kind !== "model" ? "collection" : getUnderlyingType(type);
+  if (constraints === undefined || underlyingType === undefined) {
+    return {};
+  }
+  const { minLength, maxLength, pattern, format, minValue, maxValue, minValueExclusive, maxValueExclusive } =
+    constraints;
+  if (underlyingType === "string") {
+    return { minLength, maxLength, pattern, format };
+  } else if (integerTypes.some((t) => t.goName === underlyingType)) {
+    /* Integers can't be compared to fractional bounds in Go, they're rounded to the integer bound admitting the same
+       values. */
+    return {
+      minValue: minValue !== undefined ? Math.ceil(minValue) : undefined,
+      maxValue: maxValue !== undefined ? Math.floor(maxValue) : undefined,
+      minValueExclusive: minValueExclusive !== undefined ? Math.floor(minValueExclusive) : undefined,
+      maxValueExclusive: maxValueExclusive !== undefined ? Math.ceil(maxValueExclusive) : undefined,
+    };
+  } else if (numericTypes.includes(underlyingType) || underlyingType === "Decimal") {
+    return { minValue, maxValue, minValueExclusive, maxValueExclusive };
+  } else if (underlyingType === "collection") {
+    return { minItems: constraints.minItems, maxItems: constraints.maxItems };
+  }
+  return {};
+}
+
+/* Returns the packages the checks of the constraints are rendered with. */
+export function getConstraintIncludes(constraints: Constraints): string[] {
+  return [
+    ...(constraints.pattern !== undefined ? ["regexp"] : []),
+    ...(constraints.minLength !== undefined || constraints.maxLength !== undefined ? ["unicode/utf8"] : []),
+  ];
+}
+
+/* Name of the package level variable holding the compiled pattern of a property or scalar. */
+export function getPatternName(...names: string[]): string {
+  return camelCase(`${names.join(" ")} pattern`);
+}
+
+export function renderPattern(name: string, pattern: string): string {
+  const literal = pattern.includes("`") ? JSON.stringify(pattern) : `\`${pattern}\``;
+  return `var ${name} = regexp.MustCompile(${literal})`;
+}
+
+/* Method calls on a dereferenced pointer need parentheses. */
+export function asReceiver(value: string): string {
+  return value.startsWith("*") ? `(${value})` : value;
+}
+
+/* Renders the statements checking value against constraints, reported at path. */
+export function renderConstraintChecks(
+  constraints: Constraints,
+  type: PropertyType,
+  value: string,
+  path: string,
+  patternName: string,
+): string[] {
+  const underlyingType = getUnderlyingType(type);
+  const asString = underlyingType === "string" && renderTypeName(type) !== "string" ? `string(${value})` : value;
+  /* Decimals are structs, they're compared through their exact value and printed through their String method. */
+  const asNumber = underlyingType === "Decimal" && renderTypeName(type) !== "Decimal" ? `Decimal(${value})` : value;
+  const compare = (operator: string, bound: number) =>
+    underlyingType === "Decimal"
+      ? `compareDecimal(${asNumber}, ${JSON.stringify(bound.toString())}) ${operator} 0`
+      : `${value} ${operator} ${bound}`;
+  const check = (condition: string, format: string, ...args: string[]) => `if ${condition} {
+    errs.addf(${[path, JSON.stringify(format), ...args].join(", ")})
+}`;
+  const checks: string[] = [];
+  const { minLength, maxLength, minValue, maxValue, minValueExclusive, maxValueExclusive, minItems, maxItems } =
+    constraints;
+  if (minLength !== undefined) {
+    checks.push(
+      check(
+        `n := utf8.RuneCountInString(${asString}); n < ${minLength}`,
+        `length must be at least ${minLength} but is %d`,
+        "n",
+      ),
+    );
+  }
+  if (maxLength !== undefined) {
+    checks.push(
+      check(
+        `n := utf8.RuneCountInString(${asString}); n > ${maxLength}`,
+        `length must be at most ${maxLength} but is %d`,
+        "n",
+      ),
+    );
+  }
+  if (constraints.pattern !== undefined) {
+    checks.push(check(`!${patternName}.MatchString(${asString})`, "must match %q", `${patternName}.String()`));
+  }
+  if (constraints.format !== undefined) {
+    const format = constraints.format;
+    checks.push(check(`!validFormat(${JSON.stringify(format)}, ${asString})`, `must be a valid ${format}`));
+  }
+  if (minValue !== undefined) {
+    checks.push(check(compare("<", minValue), `must be at least ${minValue} but is %v`, asNumber));
+  }
+  if (minValueExclusive !== undefined) {
+    checks.push(
+      check(compare("<=", minValueExclusive), `must be greater than ${minValueExclusive} but is %v`, asNumber),
+    );
+  }
+  if (maxValue !== undefined) {
+    checks.push(check(compare(">", maxValue), `must be at most ${maxValue} but is %v`, asNumber));
+  }
+  if (maxValueExclusive !== undefined) {
+    checks.push(
+      check(compare(">=", maxValueExclusive), `must be less than ${maxValueExclusive} but is %v`, asNumber),
+    );
+  }
+  if (minItems !== undefined) {
+    checks.push(check(`n := len(${value}); n < ${minItems}`, `must have at least ${minItems} items but has %d`, "n"));
+  }
+  if (maxItems !== undefined) {
+    checks.push(check(`n := len(${value}); n > ${maxItems}`, `must have at most ${maxItems} items but has %d`, "n"));
+  }
+  return checks;
+}
+
+function renderTypeName(type: PropertyType): Optional<string> {
+  return type.kind === "model" ? type.type.goName : undefined;
+}
+
+/* Returns whether values of the type contain something to validate. */
+export function isValidated(type: PropertyType): boolean {
+  if (type.kind === "template_instance") {
+    return type.template.kind === "model" || type.args.some((a) => a.kind === "type" && isValidated(a.type));
+  } else if (type.kind === "nullable") {
+    return isValidated(type.type);
+  } else if (type.kind === "type_parameter") {
+    return true;
+  } else if (type.kind === "constant") {
+    return false;
+  }
+  switch (type.type.kind) {
+    case "model":
+    case "type_union":
+      return true;
+    case "scalar":
+      return (type.type as ScalarSymbol).isValidated();
+    case "tuple":
+      return (type.type as TupleSymbol).items.some(isValidated);
+    default:
+      return false;
+  }
+}
+
+/* Renders the statements validating what value contains, the loops over nested collections are numbered by depth. */
+export function renderNestedValidation(type: PropertyType, value: string, path: string, depth: number = 0): string[] {
+  if (!isValidated(type)) {
+    return [];
+  }
+  if (type.kind === "type_parameter" || (type.kind === "model" && type.type.kind === "type_union")) {
+    /* The type of the value is only known at run time. */
+    return [`validateValue(errs, ${path}, ${value})`];
+  } else if (type.kind === "nullable") {
+    return [
+      `if ${value} != nil {
+${indent(renderNestedValidation(type.type, `*${value}`, path, depth))}
+}`,
+    ];
+  } else if (type.kind === "template_instance" && type.template.kind !== "model") {
+    const arg = type.args[0];
+    if (arg.kind !== "type") {
+      return [];
+    }
+    const [key, elementPath] =
+      type.template.name === "Record"
+        ? [`k${depth}`, `keyPath(${path}, k${depth})`]
+        : [`i${depth}`, `indexPath(${path}, i${depth})`];
+    return [
+      `for ${key}, e${depth} := range ${value} {
+${indent(renderNestedValidation(arg.type, `e${depth}`, elementPath, depth + 1))}
+}`,
+    ];
+  }
+  return [`${asReceiver(value)}.validate(errs, ${path})`];
+}
+
+/* Joins statements, prefixing each of their lines to nest them in a block. */
+export function indent(statements: string[], prefix: string = "    "): string {
+  return statements
+    .join("\n")
+    .split("\n")
+    .map((line) => (line !== "" ? `${prefix}${line}` : line))
+    .join("\n");
+}
@@ -1,15 +1,9 @@
 import fs from "fs/promises";
 import path from "path";
 
+/* Collapses whitespace, so that the emitted code is compared token by token regardless of its formatting. */
 export function normalizeCode(code: string): string {
-  return code
-    .replace(/\r\n/g, "\n")
-    .replace(/\S+/g, " ")
-    .split("\n")
-    .filter((line) => line.trim().length > 0)
-    .map((line) => line.trimEnd())
-    .map((line) => line.trimStart())
-    .join("\n");
+  return code.replace(/\s+/g, " ").trim();
 }
 
 async function readTestData(prefix: string): Promise<[string, string]> {
@@ -25,6 +19,11 @@ export function baseGetTestData(prefix: string): Promise<[string, string]> {
   return readTestData(fullPrefix);
 }
 
+/* Reads the expected content of a file emitted next to models.go, like validation.go. */
+export function baseGetExpectedOutput(prefix: string): Promise<string> {
+  return fs.readFile(path.join(__dirname, "data", `${prefix}.go`), "utf-8");
+}
+
 export function scopeGetTestData(prefix: string, getTestData: (path: string) => Promise<[string, string]>) {
   return async (file: string) => {
     return getTestData(path.join(prefix, file));
@@ -0,0 +1,161 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type ServerConfig struct {
+	Host                 string
+	Region               *string
+	AdditionalProperties map[string]string
+}
+
+func (m *ServerConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["host"]; ok {
+		if err := json.Unmarshal(v, &m.Host); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["region"]; ok {
+		if err := json.Unmarshal(v, &m.Region); err != nil {
+			return err
+		}
+	}
+	for key, v := range rawMsg {
+		switch key {
+		case "host", "region":
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(v, &value); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = map[string]string{}
+		}
+		m.AdditionalProperties[key] = value
+	}
+	return nil
+}
+
+func (m ServerConfig) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"host": m.Host,
+	}
+
+	if m.Region != nil {
+		obj["region"] = m.Region
+	}
+	for key, value := range m.AdditionalProperties {
+		switch key {
+		case "host", "region":
+			return nil, fmt.Errorf("additional property %q of ServerConfig conflicts with a declared property", key)
+		}
+		obj[key] = value
+	}
+
+	return json.Marshal(obj)
+}
+
+type RegionalConfig struct {
+	ServerConfig
+	Zone string
+}
+
+func (m *RegionalConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["host"]; ok {
+		if err := json.Unmarshal(v, &m.Host); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["region"]; ok {
+		if err := json.Unmarshal(v, &m.Region); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["zone"]; ok {
+		if err := json.Unmarshal(v, &m.Zone); err != nil {
+			return err
+		}
+	}
+	for key, v := range rawMsg {
+		switch key {
+		case "host", "region", "zone":
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(v, &value); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = map[string]string{}
+		}
+		m.AdditionalProperties[key] = value
+	}
+	return nil
+}
+
+func (m RegionalConfig) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"host": m.Host,
+		"zone": m.Zone,
+	}
+
+	if m.Region != nil {
+		obj["region"] = m.Region
+	}
+	for key, value := range m.AdditionalProperties {
+		switch key {
+		case "host", "region", "zone":
+			return nil, fmt.Errorf("additional property %q of RegionalConfig conflicts with a declared property", key)
+		}
+		obj[key] = value
+	}
+
+	return json.Marshal(obj)
+}
+
+// TenantLimits Limits applied to a tenant, keyed by resource.
+type TenantLimits struct {
+	AdditionalProperties map[string]time.Duration
+}
+
+func (m *TenantLimits) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	for key, v := range rawMsg {
+		var value time.Duration
+		if err := unmarshalDurationInternal(v, &value); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = map[string]time.Duration{}
+		}
+		m.AdditionalProperties[key] = value
+	}
+	return nil
+}
+
+func (m TenantLimits) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{}
+
+	for key, value := range m.AdditionalProperties {
+		obj[key] = serializeDurationInternal(value)
+	}
+
+	return json.Marshal(obj)
+}
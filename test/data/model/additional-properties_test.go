@@ -0,0 +1,82 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAdditionalPropertiesDeserialization(t *testing.T) {
+	data := []byte(`{"host": "db.internal", "zone": "b", "tier": "gold", "owner": "ops"}`)
+
+	var config RegionalConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		t.Fatalf("Failed to unmarshal RegionalConfig: %v", err)
+	}
+
+	if config.Host != "db.internal" || config.Zone != "b" || config.Region != nil {
+		t.Errorf("Unexpected declared properties %+v", config)
+	}
+	if len(config.AdditionalProperties) != 2 ||
+		config.AdditionalProperties["tier"] != "gold" ||
+		config.AdditionalProperties["owner"] != "ops" {
+		t.Errorf("Unexpected additional properties %v", config.AdditionalProperties)
+	}
+}
+
+func TestAdditionalPropertiesSerialization(t *testing.T) {
+	config := ServerConfig{
+		Host:                 "db.internal",
+		AdditionalProperties: map[string]string{"tier": "gold"},
+	}
+
+	serialized, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("Failed to marshal ServerConfig: %v", err)
+	}
+	expected := `{"host":"db.internal","tier":"gold"}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+}
+
+func TestAdditionalPropertiesConflict(t *testing.T) {
+	config := ServerConfig{
+		Host:                 "db.internal",
+		AdditionalProperties: map[string]string{"region": "eu"},
+	}
+
+	if _, err := json.Marshal(config); err == nil {
+		t.Errorf("Expected an error for an additional property named like a declared one")
+	}
+}
+
+func TestAdditionalPropertiesRecord(t *testing.T) {
+	data := []byte(`{"cpu": "PT30S", "memory": "PT1M"}`)
+
+	var limits TenantLimits
+	if err := json.Unmarshal(data, &limits); err != nil {
+		t.Fatalf("Failed to unmarshal TenantLimits: %v", err)
+	}
+	if limits.AdditionalProperties["cpu"] != 30*time.Second || limits.AdditionalProperties["memory"] != time.Minute {
+		t.Errorf("Unexpected limits %v", limits.AdditionalProperties)
+	}
+
+	serialized, err := json.Marshal(limits)
+	if err != nil {
+		t.Fatalf("Failed to marshal TenantLimits: %v", err)
+	}
+	expected := `{"cpu":"PT30S","memory":"PT1M"}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+}
+
+func TestAdditionalPropertiesError(t *testing.T) {
+	var limits TenantLimits
+	err := json.Unmarshal([]byte(`{"cpu": "PT30S", "memory": "soon"}`), &limits)
+	if err == nil || !strings.HasPrefix(err.Error(), "memory: ") {
+		t.Errorf("Expected an error naming the memory key but got %v", err)
+	}
+}
@@ -0,0 +1,284 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type PaymentCardValue struct {
+	Number string
+}
+
+func (m *PaymentCardValue) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["number"]; ok {
+		if err := json.Unmarshal(v, &m.Number); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m PaymentCardValue) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"number": m.Number,
+	}
+
+	return json.Marshal(obj)
+}
+
+type PaymentTransferValue struct {
+	Iban string
+}
+
+func (m *PaymentTransferValue) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["iban"]; ok {
+		if err := json.Unmarshal(v, &m.Iban); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m PaymentTransferValue) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"iban": m.Iban,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Payment interface {
+	Type() string
+}
+
+type PaymentCard struct {
+	Value PaymentCardValue
+}
+
+func (v PaymentCard) Type() string {
+	return "Card"
+}
+
+type PaymentTransfer struct {
+	Value PaymentTransferValue
+}
+
+func (v PaymentTransfer) Type() string {
+	return "Transfer"
+}
+
+func UnmarshalPayment(data []byte) (Payment, error) {
+	var err error
+
+	var card PaymentCardValue
+	if err = json.Unmarshal(data, &card); err == nil {
+		return PaymentCard{Value: card}, nil
+	}
+
+	var transfer PaymentTransferValue
+	if err = json.Unmarshal(data, &transfer); err == nil {
+		return PaymentTransfer{Value: transfer}, nil
+	}
+
+	return nil, err
+}
+
+type Itinerary struct {
+	Stops   []ItineraryStopsItem
+	Notes   map[string]ItineraryNotesValue
+	Legs    []ItineraryLegsItem
+	Payment Payment
+	Ratings map[string]ItineraryRatingsValue
+}
+
+func (m *Itinerary) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["stops"]; ok {
+		if err := json.Unmarshal(v, &m.Stops); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["notes"]; ok {
+		if err := json.Unmarshal(v, &m.Notes); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["legs"]; ok {
+		if err := json.Unmarshal(v, &m.Legs); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["payment"]; ok {
+		value, err := UnmarshalPayment(v)
+		if err != nil {
+			return err
+		}
+		m.Payment = value
+	}
+	if v, ok := rawMsg["ratings"]; ok {
+		if err := unmarshalMap(v, &m.Ratings, unmarshalWith(UnmarshalItineraryRatingsValue)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Itinerary) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"stops":   m.Stops,
+		"notes":   m.Notes,
+		"legs":    m.Legs,
+		"payment": m.Payment,
+		"ratings": m.Ratings,
+	}
+
+	return json.Marshal(obj)
+}
+
+type ItineraryStopsItem struct {
+	Name    string
+	Minutes int32
+}
+
+func (m *ItineraryStopsItem) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["minutes"]; ok {
+		if err := json.Unmarshal(v, &m.Minutes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m ItineraryStopsItem) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name":    m.Name,
+		"minutes": m.Minutes,
+	}
+
+	return json.Marshal(obj)
+}
+
+type ItineraryNotesValue struct {
+	Author string
+}
+
+func (m *ItineraryNotesValue) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["author"]; ok {
+		if err := json.Unmarshal(v, &m.Author); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m ItineraryNotesValue) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"author": m.Author,
+	}
+
+	return json.Marshal(obj)
+}
+
+type ItineraryLegsItemMode string
+
+const (
+	ItineraryLegsItemModeTrain ItineraryLegsItemMode = "train"
+	ItineraryLegsItemModeBus   ItineraryLegsItemMode = "bus"
+)
+
+func (f *ItineraryLegsItemMode) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = ItineraryLegsItemMode(v)
+	return nil
+}
+
+func (f ItineraryLegsItemMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(f))
+}
+
+type ItineraryLegsItem struct {
+	Mode ItineraryLegsItemMode
+}
+
+func (m *ItineraryLegsItem) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["mode"]; ok {
+		if err := json.Unmarshal(v, &m.Mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m ItineraryLegsItem) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"mode": m.Mode,
+	}
+
+	return json.Marshal(obj)
+}
+
+type ItineraryRatingsValue interface {
+	Type() string
+}
+
+type ItineraryRatingsValueInt32 struct {
+	Value int32
+}
+
+func (v ItineraryRatingsValueInt32) Type() string {
+	return "int32"
+}
+
+type ItineraryRatingsValueString struct {
+	Value string
+}
+
+func (v ItineraryRatingsValueString) Type() string {
+	return "string"
+}
+
+func UnmarshalItineraryRatingsValue(data []byte) (ItineraryRatingsValue, error) {
+	var err error
+
+	var int32 int32
+	if err = json.Unmarshal(data, &int32); err == nil {
+		return ItineraryRatingsValueInt32{Value: int32}, nil
+	}
+
+	var string string
+	if err = json.Unmarshal(data, &string); err == nil {
+		return ItineraryRatingsValueString{Value: string}, nil
+	}
+
+	return nil, err
+}
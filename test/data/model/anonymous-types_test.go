@@ -0,0 +1,58 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAnonymousTypesDeserialization(t *testing.T) {
+	data := []byte(`{
+		"stops": [{"name": "Lyon", "minutes": 12}],
+		"notes": {"lyon": {"author": "ana"}},
+		"legs": [{"mode": "train"}, {"mode": "bus"}],
+		"payment": {"number": "4111"},
+		"ratings": {"comfort": 4, "food": "n/a"}
+	}`)
+
+	var itinerary Itinerary
+	if err := json.Unmarshal(data, &itinerary); err != nil {
+		t.Fatalf("Failed to unmarshal Itinerary: %v", err)
+	}
+
+	if len(itinerary.Stops) != 1 || itinerary.Stops[0] != (ItineraryStopsItem{Name: "Lyon", Minutes: 12}) {
+		t.Errorf("Unexpected stops %+v", itinerary.Stops)
+	}
+	if itinerary.Notes["lyon"].Author != "ana" {
+		t.Errorf("Unexpected notes %+v", itinerary.Notes)
+	}
+	if len(itinerary.Legs) != 2 || itinerary.Legs[1].Mode != ItineraryLegsItemModeBus {
+		t.Errorf("Unexpected legs %+v", itinerary.Legs)
+	}
+	card, ok := itinerary.Payment.(PaymentCard)
+	if !ok || card.Value.Number != "4111" {
+		t.Errorf("Expected a card payment but got %+v", itinerary.Payment)
+	}
+	if itinerary.Ratings["comfort"] != (ItineraryRatingsValueInt32{Value: 4}) {
+		t.Errorf("Unexpected comfort rating %+v", itinerary.Ratings["comfort"])
+	}
+	if itinerary.Ratings["food"] != (ItineraryRatingsValueString{Value: "n/a"}) {
+		t.Errorf("Unexpected food rating %+v", itinerary.Ratings["food"])
+	}
+}
+
+func TestAnonymousTypesSerialization(t *testing.T) {
+	itinerary := Itinerary{
+		Stops: []ItineraryStopsItem{{Name: "Lyon", Minutes: 12}},
+		Notes: map[string]ItineraryNotesValue{"lyon": {Author: "ana"}},
+		Legs:  []ItineraryLegsItem{{Mode: ItineraryLegsItemModeTrain}},
+	}
+
+	serialized, err := json.Marshal(itinerary)
+	if err != nil {
+		t.Fatalf("Failed to marshal Itinerary: %v", err)
+	}
+	expected := `{"legs":[{"mode":"train"}],"notes":{"lyon":{"author":"ana"}},"payment":null,"ratings":null,"stops":[{"minutes":12,"name":"Lyon"}]}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+}
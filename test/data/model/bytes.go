@@ -0,0 +1,64 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type AudioChunk struct {
+	Data      []byte
+	Signature []byte
+	Segments  [][]byte
+	Checksum  *[]byte
+	Thumbnail Nullable[[]byte]
+}
+
+func (m *AudioChunk) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["data"]; ok {
+		if err := unmarshalBytesBase64Internal(v, &m.Data); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["signature"]; ok {
+		if err := unmarshalBytesBase64URLInternal(v, &m.Signature); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["segments"]; ok {
+		if err := unmarshalSlice(v, &m.Segments, unmarshalBytesBase64Internal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["checksum"]; ok {
+		if err := unmarshalPointer(v, &m.Checksum, unmarshalBytesBase64Internal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["thumbnail"]; ok {
+		if err := unmarshalNullable(v, &m.Thumbnail, unmarshalBytesBase64URLInternal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m AudioChunk) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"data":      serializeBytesBase64Internal(m.Data),
+		"signature": serializeBytesBase64URLInternal(m.Signature),
+		"segments":  serializeSlice(m.Segments, serializeBytesBase64Internal),
+	}
+
+	if m.Thumbnail.IsSet() {
+		obj["thumbnail"] = serializeNullable(m.Thumbnail, serializeBytesBase64URLInternal)
+	}
+
+	if m.Checksum != nil {
+		obj["checksum"] = serializeBytesBase64Internal(*m.Checksum)
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,61 @@
+package modeltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestBytesSerialization(t *testing.T) {
+	chunk := AudioChunk{
+		Data:      []byte{0xfb, 0xff, 0x01},
+		Signature: []byte{0xfb, 0xff},
+		Segments:  [][]byte{[]byte("ab"), []byte("c")},
+		Checksum:  Ptr([]byte{0x00}),
+		Thumbnail: NullNullable[[]byte](),
+	}
+
+	data, err := json.Marshal(chunk)
+	if err != nil {
+		t.Fatalf("Failed to marshal AudioChunk: %v", err)
+	}
+
+	expected := `{"checksum":"AA==","data":"+/8B","segments":["YWI=","Yw=="],"signature":"-_8","thumbnail":null}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(data))
+	}
+}
+
+func TestBytesDeserialization(t *testing.T) {
+	data := []byte(`{"data":"+/8B","signature":"-_8=","segments":["YWI=","Yw=="],"checksum":"AA==","thumbnail":"-_8"}`)
+
+	var chunk AudioChunk
+	if err := json.Unmarshal(data, &chunk); err != nil {
+		t.Fatalf("Failed to unmarshal AudioChunk: %v", err)
+	}
+
+	if !bytes.Equal(chunk.Data, []byte{0xfb, 0xff, 0x01}) {
+		t.Errorf("Unexpected data %v", chunk.Data)
+	}
+	if !bytes.Equal(chunk.Signature, []byte{0xfb, 0xff}) {
+		t.Errorf("Unexpected signature %v", chunk.Signature)
+	}
+	if len(chunk.Segments) != 2 || string(chunk.Segments[0]) != "ab" || string(chunk.Segments[1]) != "c" {
+		t.Errorf("Unexpected segments %v", chunk.Segments)
+	}
+	if chunk.Checksum == nil || !bytes.Equal(*chunk.Checksum, []byte{0x00}) {
+		t.Errorf("Unexpected checksum %v", chunk.Checksum)
+	}
+	if !chunk.Thumbnail.IsSet() || !bytes.Equal(chunk.Thumbnail.Value(), []byte{0xfb, 0xff}) {
+		t.Errorf("Unexpected thumbnail %v", chunk.Thumbnail)
+	}
+}
+
+func TestBytesDeserializationInvalid(t *testing.T) {
+	for _, data := range []string{`{"data":"-_8"}`, `{"signature":"+/8"}`, `{"segments":["%%"]}`} {
+		var chunk AudioChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err == nil {
+			t.Errorf("Expected an error for %s", data)
+		}
+	}
+}
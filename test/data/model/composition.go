@@ -0,0 +1,312 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+// Audit Fields shared by audited resources.
+type Audit struct {
+	// CreatedBy Who created the resource.
+	CreatedBy string
+	UpdatedAt *int64
+}
+
+func (m *Audit) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["created_by"]; ok {
+		if err := json.Unmarshal(v, &m.CreatedBy); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["updatedAt"]; ok {
+		if err := json.Unmarshal(v, &m.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Audit) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"created_by": m.CreatedBy,
+	}
+
+	if m.UpdatedAt != nil {
+		obj["updatedAt"] = m.UpdatedAt
+	}
+
+	return json.Marshal(obj)
+}
+
+type Contact struct {
+	// Email Address the notifications are sent to.
+	Email string
+	Phone *string
+}
+
+func (m *Contact) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["email"]; ok {
+		if err := json.Unmarshal(v, &m.Email); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["phone"]; ok {
+		if err := json.Unmarshal(v, &m.Phone); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Contact) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"email": m.Email,
+	}
+
+	if m.Phone != nil {
+		obj["phone"] = m.Phone
+	}
+
+	return json.Marshal(obj)
+}
+
+type Customer struct {
+	Name string
+	// CreatedBy Who created the resource.
+	CreatedBy string
+	UpdatedAt *int64
+}
+
+func (m *Customer) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["created_by"]; ok {
+		if err := json.Unmarshal(v, &m.CreatedBy); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["updatedAt"]; ok {
+		if err := json.Unmarshal(v, &m.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Customer) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name":       m.Name,
+		"created_by": m.CreatedBy,
+	}
+
+	if m.UpdatedAt != nil {
+		obj["updatedAt"] = m.UpdatedAt
+	}
+
+	return json.Marshal(obj)
+}
+
+type Supplier struct {
+	// Email Address the notifications are sent to.
+	Email     string
+	Phone     *string
+	VatNumber string
+}
+
+func (m *Supplier) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["email"]; ok {
+		if err := json.Unmarshal(v, &m.Email); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["phone"]; ok {
+		if err := json.Unmarshal(v, &m.Phone); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["vatNumber"]; ok {
+		if err := json.Unmarshal(v, &m.VatNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Supplier) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"email":     m.Email,
+		"vatNumber": m.VatNumber,
+	}
+
+	if m.Phone != nil {
+		obj["phone"] = m.Phone
+	}
+
+	return json.Marshal(obj)
+}
+
+type PurchaseOrderBillTo struct {
+	// Email Address the notifications are sent to.
+	Email string
+	Phone *string
+	// CreatedBy Who created the resource.
+	CreatedBy string
+	UpdatedAt *int64
+}
+
+func (m *PurchaseOrderBillTo) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["email"]; ok {
+		if err := json.Unmarshal(v, &m.Email); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["phone"]; ok {
+		if err := json.Unmarshal(v, &m.Phone); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["created_by"]; ok {
+		if err := json.Unmarshal(v, &m.CreatedBy); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["updatedAt"]; ok {
+		if err := json.Unmarshal(v, &m.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m PurchaseOrderBillTo) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"email":      m.Email,
+		"created_by": m.CreatedBy,
+	}
+
+	if m.Phone != nil {
+		obj["phone"] = m.Phone
+	}
+	if m.UpdatedAt != nil {
+		obj["updatedAt"] = m.UpdatedAt
+	}
+
+	return json.Marshal(obj)
+}
+
+type AuditedContact struct {
+	// Email Address the notifications are sent to.
+	Email string
+	Phone *string
+	// CreatedBy Who created the resource.
+	CreatedBy string
+	UpdatedAt *int64
+}
+
+func (m *AuditedContact) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["email"]; ok {
+		if err := json.Unmarshal(v, &m.Email); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["phone"]; ok {
+		if err := json.Unmarshal(v, &m.Phone); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["created_by"]; ok {
+		if err := json.Unmarshal(v, &m.CreatedBy); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["updatedAt"]; ok {
+		if err := json.Unmarshal(v, &m.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m AuditedContact) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"email":      m.Email,
+		"created_by": m.CreatedBy,
+	}
+
+	if m.Phone != nil {
+		obj["phone"] = m.Phone
+	}
+	if m.UpdatedAt != nil {
+		obj["updatedAt"] = m.UpdatedAt
+	}
+
+	return json.Marshal(obj)
+}
+
+type PurchaseOrder struct {
+	Number int32
+	BillTo PurchaseOrderBillTo
+	ShipTo *AuditedContact
+}
+
+func (m *PurchaseOrder) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["number"]; ok {
+		if err := json.Unmarshal(v, &m.Number); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["billTo"]; ok {
+		if err := json.Unmarshal(v, &m.BillTo); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["shipTo"]; ok {
+		if err := json.Unmarshal(v, &m.ShipTo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m PurchaseOrder) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"number": m.Number,
+		"billTo": m.BillTo,
+	}
+
+	if m.ShipTo != nil {
+		obj["shipTo"] = m.ShipTo
+	}
+
+	return json.Marshal(obj)
+}
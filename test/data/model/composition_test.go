@@ -0,0 +1,79 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCompositionSpreadKeepsEncodedNames(t *testing.T) {
+	data := []byte(`{"name": "Acme", "created_by": "jane", "updatedAt": 1700000000}`)
+
+	var customer Customer
+	if err := json.Unmarshal(data, &customer); err != nil {
+		t.Fatalf("Failed to unmarshal Customer: %v", err)
+	}
+	if customer.Name != "Acme" || customer.CreatedBy != "jane" {
+		t.Errorf("Unexpected name %q or creator %q", customer.Name, customer.CreatedBy)
+	}
+	if customer.UpdatedAt == nil || *customer.UpdatedAt != 1700000000 {
+		t.Errorf("Expected updatedAt to be set but got %v", customer.UpdatedAt)
+	}
+
+	serialized, err := json.Marshal(customer)
+	if err != nil {
+		t.Fatalf("Failed to marshal Customer: %v", err)
+	}
+	expected := `{"created_by":"jane","name":"Acme","updatedAt":1700000000}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+}
+
+func TestCompositionIsCopiesProperties(t *testing.T) {
+	data := []byte(`{"email": "sales@example.com", "vatNumber": "FR123"}`)
+
+	var supplier Supplier
+	if err := json.Unmarshal(data, &supplier); err != nil {
+		t.Fatalf("Failed to unmarshal Supplier: %v", err)
+	}
+	if supplier.Email != "sales@example.com" || supplier.VatNumber != "FR123" || supplier.Phone != nil {
+		t.Errorf("Unexpected supplier %+v", supplier)
+	}
+}
+
+func TestCompositionIntersection(t *testing.T) {
+	order := PurchaseOrder{
+		Number: 7,
+		BillTo: PurchaseOrderBillTo{Email: "billing@example.com", CreatedBy: "john"},
+	}
+
+	serialized, err := json.Marshal(order)
+	if err != nil {
+		t.Fatalf("Failed to marshal PurchaseOrder: %v", err)
+	}
+	expected := `{"billTo":{"created_by":"john","email":"billing@example.com"},"number":7}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+
+	var decoded PurchaseOrder
+	if err := json.Unmarshal(serialized, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal PurchaseOrder: %v", err)
+	}
+	if decoded != order {
+		t.Errorf("Expected %+v but got %+v", order, decoded)
+	}
+}
+
+func TestCompositionAliasedIntersection(t *testing.T) {
+	data := []byte(`{"number": 8, "billTo": {"email": "billing@example.com", "created_by": "john"}, "shipTo": {"email": "dock@example.com", "created_by": "jane"}}`)
+
+	var order PurchaseOrder
+	if err := json.Unmarshal(data, &order); err != nil {
+		t.Fatalf("Failed to unmarshal PurchaseOrder: %v", err)
+	}
+	expected := AuditedContact{Email: "dock@example.com", CreatedBy: "jane"}
+	if order.ShipTo == nil || *order.ShipTo != expected {
+		t.Errorf("Expected ship to %+v but got %+v", expected, order.ShipTo)
+	}
+}
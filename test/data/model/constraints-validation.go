@@ -0,0 +1,142 @@
+package modeltest
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func (s CurrencyCode) validate(errs *violations, path string) {
+	if !currencyCodePattern.MatchString(string(s)) {
+		errs.addf(path, "must match %q", currencyCodePattern.String())
+	}
+}
+
+// Validate checks the constraints of Money and of the values it holds, the error lists every violation.
+func (m Money) Validate() error {
+	var errs violations
+	m.validate(&errs, "")
+	return errs.err()
+}
+
+func (m Money) validate(errs *violations, path string) {
+	if m.Amount <= 0 {
+		errs.addf(joinPath(path, "amount"), "must be greater than 0 but is %v", m.Amount)
+	}
+	m.Currency.validate(errs, joinPath(path, "currency"))
+	if m.TaxRate != nil {
+		if compareDecimal(*m.TaxRate, "0") < 0 {
+			errs.addf(joinPath(path, "taxRate"), "must be at least 0 but is %v", *m.TaxRate)
+		}
+		if compareDecimal(*m.TaxRate, "1") >= 0 {
+			errs.addf(joinPath(path, "taxRate"), "must be less than 1 but is %v", *m.TaxRate)
+		}
+	}
+}
+
+func (s Quantity) validate(errs *violations, path string) {
+	if s < 1 {
+		errs.addf(path, "must be at least 1 but is %v", s)
+	}
+}
+
+// Validate checks the constraints of LineItem and of the values it holds, the error lists every violation.
+func (m LineItem) Validate() error {
+	var errs violations
+	m.validate(&errs, "")
+	return errs.err()
+}
+
+func (m LineItem) validate(errs *violations, path string) {
+	if n := utf8.RuneCountInString(m.Sku); n < 1 {
+		errs.addf(joinPath(path, "sku"), "length must be at least 1 but is %d", n)
+	}
+	if n := utf8.RuneCountInString(m.Sku); n > 40 {
+		errs.addf(joinPath(path, "sku"), "length must be at most 40 but is %d", n)
+	}
+	m.Quantity.validate(errs, joinPath(path, "quantity"))
+	if m.DiscountPercent != nil {
+		if *m.DiscountPercent < 0 {
+			errs.addf(joinPath(path, "discountPercent"), "must be at least 0 but is %v", *m.DiscountPercent)
+		}
+		if *m.DiscountPercent > 100 {
+			errs.addf(joinPath(path, "discountPercent"), "must be at most 100 but is %v", *m.DiscountPercent)
+		}
+	}
+	if m.Weight != nil {
+		if *m.Weight < 1 {
+			errs.addf(joinPath(path, "weight"), "must be at least 1 but is %v", *m.Weight)
+		}
+	}
+	m.Price.validate(errs, joinPath(path, "price"))
+}
+
+var cardPaymentNumberPattern = regexp.MustCompile(`^[0-9]{16}$`)
+
+// Validate checks the constraints of CardPayment and of the values it holds, the error lists every violation.
+func (m CardPayment) Validate() error {
+	var errs violations
+	m.validate(&errs, "")
+	return errs.err()
+}
+
+func (m CardPayment) validate(errs *violations, path string) {
+	if !cardPaymentNumberPattern.MatchString(m.Number) {
+		errs.addf(joinPath(path, "number"), "must match %q", cardPaymentNumberPattern.String())
+	}
+}
+
+func (s VoucherCode) validate(errs *violations, path string) {
+	if n := utf8.RuneCountInString(string(s)); n < 8 {
+		errs.addf(path, "length must be at least 8 but is %d", n)
+	}
+	if n := utf8.RuneCountInString(string(s)); n > 12 {
+		errs.addf(path, "length must be at most 12 but is %d", n)
+	}
+}
+
+func (v PaymentMethodCardPayment) validate(errs *violations, path string) {
+	v.Value.validate(errs, path)
+}
+
+func (v PaymentMethodVoucherCode) validate(errs *violations, path string) {
+	v.Value.validate(errs, path)
+}
+
+// Validate checks the constraints of Order and of the values it holds, the error lists every violation.
+func (m Order) Validate() error {
+	var errs violations
+	m.validate(&errs, "")
+	return errs.err()
+}
+
+func (m Order) validate(errs *violations, path string) {
+	if !validFormat("uuid", m.Id) {
+		errs.addf(joinPath(path, "id"), "must be a valid uuid")
+	}
+	if m.Contact != nil {
+		if !validFormat("email", *m.Contact) {
+			errs.addf(joinPath(path, "contact"), "must be a valid email")
+		}
+	}
+	if n := len(m.Items); n < 1 {
+		errs.addf(joinPath(path, "items"), "must have at least 1 items but has %d", n)
+	}
+	if n := len(m.Items); n > 10 {
+		errs.addf(joinPath(path, "items"), "must have at most 10 items but has %d", n)
+	}
+	for i0, e0 := range m.Items {
+		e0.validate(errs, indexPath(joinPath(path, "items"), i0))
+	}
+	for k0, e0 := range m.Totals {
+		e0.validate(errs, keyPath(joinPath(path, "totals"), k0))
+	}
+	validateValue(errs, joinPath(path, "payment"), m.Payment)
+	if m.Note.value != nil {
+		if n := utf8.RuneCountInString(*m.Note.value); n > 200 {
+			errs.addf(joinPath(path, "note"), "length must be at most 200 but is %d", n)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type CurrencyCode string
+
+type Money struct {
+	Amount   float64
+	Currency CurrencyCode
+	TaxRate  *Decimal
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["amount"]; ok {
+		if err := json.Unmarshal(v, &m.Amount); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["currency"]; ok {
+		if err := json.Unmarshal(v, &m.Currency); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["taxRate"]; ok {
+		if err := json.Unmarshal(v, &m.TaxRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"amount":   m.Amount,
+		"currency": m.Currency,
+	}
+
+	if m.TaxRate != nil {
+		obj["taxRate"] = m.TaxRate
+	}
+
+	return json.Marshal(obj)
+}
+
+type Quantity int32
+
+type LineItem struct {
+	Sku             string
+	Quantity        Quantity
+	DiscountPercent *float32
+	Weight          *int32
+	Price           Money
+}
+
+func (m *LineItem) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["sku"]; ok {
+		if err := json.Unmarshal(v, &m.Sku); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["quantity"]; ok {
+		if err := json.Unmarshal(v, &m.Quantity); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["discountPercent"]; ok {
+		if err := json.Unmarshal(v, &m.DiscountPercent); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["weight"]; ok {
+		if err := json.Unmarshal(v, &m.Weight); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["price"]; ok {
+		if err := json.Unmarshal(v, &m.Price); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m LineItem) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"sku":      m.Sku,
+		"quantity": m.Quantity,
+		"price":    m.Price,
+	}
+
+	if m.DiscountPercent != nil {
+		obj["discountPercent"] = m.DiscountPercent
+	}
+	if m.Weight != nil {
+		obj["weight"] = m.Weight
+	}
+
+	return json.Marshal(obj)
+}
+
+type CardPayment struct {
+	Number string
+}
+
+func (m *CardPayment) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["number"]; ok {
+		if err := json.Unmarshal(v, &m.Number); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m CardPayment) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"number": m.Number,
+	}
+
+	return json.Marshal(obj)
+}
+
+type VoucherCode string
+
+type PaymentMethod interface {
+	Type() string
+}
+
+type PaymentMethodCardPayment struct {
+	Value CardPayment
+}
+
+func (v PaymentMethodCardPayment) Type() string {
+	return "CardPayment"
+}
+
+type PaymentMethodVoucherCode struct {
+	Value VoucherCode
+}
+
+func (v PaymentMethodVoucherCode) Type() string {
+	return "VoucherCode"
+}
+
+func UnmarshalPaymentMethod(data []byte) (PaymentMethod, error) {
+	var err error
+
+	var cardPayment CardPayment
+	if err = json.Unmarshal(data, &cardPayment); err == nil {
+		return PaymentMethodCardPayment{Value: cardPayment}, nil
+	}
+
+	var voucherCode VoucherCode
+	if err = json.Unmarshal(data, &voucherCode); err == nil {
+		return PaymentMethodVoucherCode{Value: voucherCode}, nil
+	}
+
+	return nil, err
+}
+
+type Order struct {
+	Id      string
+	Contact *string
+	Items   []LineItem
+	Totals  map[string]Money
+	Payment PaymentMethod
+	Note    Nullable[string]
+}
+
+func (m *Order) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["id"]; ok {
+		if err := json.Unmarshal(v, &m.Id); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["contact"]; ok {
+		if err := json.Unmarshal(v, &m.Contact); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["items"]; ok {
+		if err := json.Unmarshal(v, &m.Items); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["totals"]; ok {
+		if err := json.Unmarshal(v, &m.Totals); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["payment"]; ok {
+		value, err := UnmarshalPaymentMethod(v)
+		if err != nil {
+			return err
+		}
+		m.Payment = value
+	}
+	if v, ok := rawMsg["note"]; ok {
+		if err := json.Unmarshal(v, &m.Note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Order) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"id":      m.Id,
+		"items":   m.Items,
+		"totals":  m.Totals,
+		"payment": m.Payment,
+	}
+
+	if m.Note.IsSet() {
+		obj["note"] = m.Note
+	}
+
+	if m.Contact != nil {
+		obj["contact"] = m.Contact
+	}
+
+	return json.Marshal(obj)
+}
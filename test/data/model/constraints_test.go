@@ -0,0 +1,89 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestConstraintsValid(t *testing.T) {
+	data := []byte(`{
+		"id": "3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+		"contact": "buyer@example.com",
+		"items": [{"sku": "A-1", "quantity": 2, "discountPercent": 10, "weight": 1, "price": {"amount": 9.5, "currency": "EUR", "taxRate": 0.2}}],
+		"totals": {"net": {"amount": 19, "currency": "EUR"}},
+		"payment": {"number": "4111111111111111"},
+		"note": null
+	}`)
+
+	var order Order
+	if err := json.Unmarshal(data, &order); err != nil {
+		t.Fatalf("Failed to unmarshal Order: %v", err)
+	}
+	if err := order.Validate(); err != nil {
+		t.Errorf("Expected a valid order but got %v", err)
+	}
+}
+
+func TestConstraintsViolations(t *testing.T) {
+	data := []byte(`{
+		"id": "order-1",
+		"contact": "nobody",
+		"items": [
+			{"sku": "A-1", "quantity": 1, "price": {"amount": 1, "currency": "EUR"}},
+			{"sku": "", "quantity": 0, "discountPercent": 120, "weight": 0, "price": {"amount": 0, "currency": "euro", "taxRate": 1}}
+		],
+		"totals": {"net": {"amount": -1, "currency": "EUR"}},
+		"payment": "SHORT",
+		"note": "ok"
+	}`)
+
+	var order Order
+	if err := json.Unmarshal(data, &order); err != nil {
+		t.Fatalf("Failed to unmarshal Order: %v", err)
+	}
+
+	var validationErr *ValidationError
+	if err := order.Validate(); !errors.As(err, &validationErr) {
+		t.Fatalf("Expected a ValidationError but got %v", err)
+	}
+	var paths []string
+	for _, v := range validationErr.Violations {
+		paths = append(paths, v.Path)
+	}
+	expected := []string{
+		"id",
+		"contact",
+		"items[1].sku",
+		"items[1].quantity",
+		"items[1].discountPercent",
+		"items[1].weight",
+		"items[1].price.amount",
+		"items[1].price.currency",
+		"items[1].price.taxRate",
+		`totals["net"].amount`,
+		"payment",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected violations at %v but got %v", expected, paths)
+	}
+}
+
+func TestConstraintsUnionVariant(t *testing.T) {
+	payment, err := UnmarshalPaymentMethod([]byte(`{"number": "4111"}`))
+	if err != nil {
+		t.Fatalf("Failed to unmarshal PaymentMethod: %v", err)
+	}
+	order := Order{
+		Id:      "3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+		Items:   []LineItem{{Sku: "A-1", Quantity: 1, Price: Money{Amount: 1, Currency: "EUR"}}},
+		Payment: payment,
+	}
+
+	err = order.Validate()
+	expected := `validation failed: payment.number: must match "^[0-9]{16}$"`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected %q but got %v", expected, err)
+	}
+}
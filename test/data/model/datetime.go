@@ -0,0 +1,76 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Appointment struct {
+	CreatedAt   time.Time
+	ScheduledAt time.Time
+	ModifiedAt  time.Time
+	ExpiresAt   *time.Time
+	RemindAt    *time.Time
+	CancelledAt Nullable[time.Time]
+}
+
+func (m *Appointment) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["createdAt"]; ok {
+		if err := unmarshalUtcDateTimeInternal(v, &m.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["scheduledAt"]; ok {
+		if err := unmarshalOffsetDateTimeInternal(v, &m.ScheduledAt); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["modifiedAt"]; ok {
+		if err := unmarshalDateTimeRFC7231Internal(v, &m.ModifiedAt); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["expiresAt"]; ok {
+		if err := unmarshalPointer(v, &m.ExpiresAt, unmarshalDateTimeUnixTimestampInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["remindAt"]; ok {
+		if err := unmarshalPointer(v, &m.RemindAt, unmarshalDateTimeUnixTimestampInt32Internal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["cancelledAt"]; ok {
+		if err := unmarshalNullable(v, &m.CancelledAt, unmarshalUtcDateTimeInternal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Appointment) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"createdAt":   serializeUtcDateTimeInternal(m.CreatedAt),
+		"scheduledAt": serializeOffsetDateTimeInternal(m.ScheduledAt),
+		"modifiedAt":  serializeDateTimeRFC7231Internal(m.ModifiedAt),
+	}
+
+	if m.CancelledAt.IsSet() {
+		obj["cancelledAt"] = serializeNullable(m.CancelledAt, serializeUtcDateTimeInternal)
+	}
+
+	if m.ExpiresAt != nil {
+		obj["expiresAt"] = serializeDateTimeUnixTimestampInternal(*m.ExpiresAt)
+	}
+	if m.RemindAt != nil {
+		obj["remindAt"] = serializeDateTimeUnixTimestampInt32Internal(*m.RemindAt)
+	}
+
+	return json.Marshal(obj)
+}
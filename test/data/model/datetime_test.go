@@ -0,0 +1,133 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateTimeSerialization(t *testing.T) {
+	offset := time.FixedZone("", 2*60*60)
+	appointment := Appointment{
+		CreatedAt:   time.Date(2024, time.March, 1, 10, 30, 0, 0, offset),
+		ScheduledAt: time.Date(2024, time.March, 2, 9, 0, 0, 500000000, offset),
+		ModifiedAt:  time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+		ExpiresAt:   Ptr(time.Unix(1709294400, 0)),
+		CancelledAt: NullNullable[time.Time](),
+	}
+
+	data, err := json.Marshal(appointment)
+	if err != nil {
+		t.Fatalf("Failed to marshal Appointment: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Failed to unmarshal serialized Appointment: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"createdAt":   "2024-03-01T08:30:00Z",
+		"scheduledAt": "2024-03-02T09:00:00.5+02:00",
+		"modifiedAt":  "Fri, 01 Mar 2024 12:00:00 GMT",
+		"expiresAt":   float64(1709294400),
+		"cancelledAt": nil,
+	}
+	for key, value := range expected {
+		actual, ok := result[key]
+		if !ok {
+			t.Errorf("Expected %s to be present", key)
+		} else if actual != value {
+			t.Errorf("Expected %s to be %v but got %v", key, value, actual)
+		}
+	}
+}
+
+func TestDateTimeDeserialization(t *testing.T) {
+	data := []byte(`{
+		"createdAt": "2024-03-01T10:30:00+02:00",
+		"scheduledAt": "2024-03-02T09:00:00.5+02:00",
+		"modifiedAt": "Fri, 01 Mar 2024 12:00:00 GMT",
+		"expiresAt": 1709294400,
+		"cancelledAt": "2024-03-03T00:00:00Z"
+	}`)
+
+	var appointment Appointment
+	if err := json.Unmarshal(data, &appointment); err != nil {
+		t.Fatalf("Failed to unmarshal Appointment: %v", err)
+	}
+
+	if appointment.CreatedAt.Location() != time.UTC || appointment.CreatedAt.Hour() != 8 {
+		t.Errorf("Expected createdAt to be normalized to UTC but got %v", appointment.CreatedAt)
+	}
+	if _, offset := appointment.ScheduledAt.Zone(); offset != 2*60*60 {
+		t.Errorf("Expected scheduledAt to keep its +02:00 offset but got %v", appointment.ScheduledAt)
+	}
+	if appointment.ScheduledAt.Nanosecond() != 500000000 {
+		t.Errorf("Expected scheduledAt to keep its fractional seconds but got %v", appointment.ScheduledAt)
+	}
+	if !appointment.ModifiedAt.Equal(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected modifiedAt %v", appointment.ModifiedAt)
+	}
+	if appointment.ExpiresAt == nil || appointment.ExpiresAt.Unix() != 1709294400 {
+		t.Errorf("Unexpected expiresAt %v", appointment.ExpiresAt)
+	}
+	if !appointment.CancelledAt.IsSet() || !appointment.CancelledAt.Value().Equal(time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected cancelledAt %v", appointment.CancelledAt)
+	}
+}
+
+func TestOffsetDateTimeRoundTrip(t *testing.T) {
+	data := []byte(`{"createdAt":"2024-03-01T10:30:00Z","scheduledAt":"2024-03-02T09:00:00-05:30","modifiedAt":"Fri, 01 Mar 2024 12:00:00 GMT"}`)
+
+	var appointment Appointment
+	if err := json.Unmarshal(data, &appointment); err != nil {
+		t.Fatalf("Failed to unmarshal Appointment: %v", err)
+	}
+	if appointment.CancelledAt.IsSet() {
+		t.Error("Expected cancelledAt to be unset")
+	}
+
+	serialized, err := json.Marshal(appointment)
+	if err != nil {
+		t.Fatalf("Failed to marshal Appointment: %v", err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(serialized, &result); err != nil {
+		t.Fatalf("Failed to unmarshal serialized Appointment: %v", err)
+	}
+	if result["scheduledAt"] != "2024-03-02T09:00:00-05:30" {
+		t.Errorf("Expected scheduledAt to round-trip but got %v", result["scheduledAt"])
+	}
+	if _, ok := result["expiresAt"]; ok {
+		t.Error("Expected expiresAt to be absent")
+	}
+}
+
+func TestDateTimeDeserializationInvalid(t *testing.T) {
+	data := []byte(`{"createdAt":"yesterday"}`)
+
+	var appointment Appointment
+	if err := json.Unmarshal(data, &appointment); err == nil {
+		t.Error("Expected an error for an invalid createdAt")
+	}
+}
+
+func TestUnixTimestampInt32(t *testing.T) {
+	var appointment Appointment
+	data := []byte(`{"createdAt":"2024-03-01T10:30:00Z","scheduledAt":"2024-03-02T09:00:00Z","modifiedAt":"Fri, 01 Mar 2024 12:00:00 GMT","remindAt":1709294400}`)
+	if err := json.Unmarshal(data, &appointment); err != nil {
+		t.Fatalf("Failed to unmarshal Appointment: %v", err)
+	}
+	if appointment.RemindAt == nil || appointment.RemindAt.Unix() != 1709294400 {
+		t.Errorf("Unexpected remindAt %v", appointment.RemindAt)
+	}
+
+	if err := json.Unmarshal([]byte(`{"remindAt":4294967296}`), &appointment); err == nil {
+		t.Error("Expected an error for a remindAt out of the int32 range")
+	}
+	appointment.RemindAt = Ptr(time.Date(2040, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if _, err := json.Marshal(appointment); err == nil {
+		t.Error("Expected an error for a remindAt after 2038")
+	}
+}
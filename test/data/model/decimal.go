@@ -0,0 +1,47 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Invoice struct {
+	Total       Decimal
+	Tax         *Decimal
+	LineAmounts []Decimal
+}
+
+func (m *Invoice) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["total"]; ok {
+		if err := json.Unmarshal(v, &m.Total); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["tax"]; ok {
+		if err := json.Unmarshal(v, &m.Tax); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["lineAmounts"]; ok {
+		if err := json.Unmarshal(v, &m.LineAmounts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Invoice) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"total":       m.Total,
+		"lineAmounts": m.LineAmounts,
+	}
+
+	if m.Tax != nil {
+		obj["tax"] = m.Tax
+	}
+
+	return json.Marshal(obj)
+}
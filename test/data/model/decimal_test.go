@@ -0,0 +1,68 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestDecimalRoundTrip(t *testing.T) {
+	data := `{"lineAmounts":[0.10,1E+3,-7],"tax":0.000000000000000000000001,"total":12345678901234567890.123456789}`
+
+	var invoice Invoice
+	if err := json.Unmarshal([]byte(data), &invoice); err != nil {
+		t.Fatalf("Failed to unmarshal Invoice: %v", err)
+	}
+
+	if invoice.Total.String() != "12345678901234567890.123456789" {
+		t.Errorf("Unexpected total %s", invoice.Total)
+	}
+	if invoice.Tax == nil || invoice.Tax.String() != "0.000000000000000000000001" {
+		t.Errorf("Unexpected tax %v", invoice.Tax)
+	}
+
+	serialized, err := json.Marshal(invoice)
+	if err != nil {
+		t.Fatalf("Failed to marshal Invoice: %v", err)
+	}
+	if string(serialized) != data {
+		t.Errorf("Expected %s, got %s", data, string(serialized))
+	}
+}
+
+func TestDecimalAccessors(t *testing.T) {
+	d, err := NewDecimal("2.50")
+	if err != nil {
+		t.Fatalf("Failed to create decimal: %v", err)
+	}
+	if d.String() != "2.50" {
+		t.Errorf("Expected 2.50, got %s", d)
+	}
+	if f, err := d.Float64(); err != nil || f != 2.5 {
+		t.Errorf("Expected 2.5, got %v (%v)", f, err)
+	}
+	if d.Rat().Cmp(big.NewRat(5, 2)) != 0 {
+		t.Errorf("Expected 5/2, got %v", d.Rat())
+	}
+	if DecimalFromRat(big.NewRat(1, 3), 4).String() != "0.3333" {
+		t.Errorf("Unexpected decimal from rat %s", DecimalFromRat(big.NewRat(1, 3), 4))
+	}
+
+	var zero Decimal
+	if zero.String() != "0" || zero.Rat().Sign() != 0 {
+		t.Errorf("Expected zero decimal, got %s", zero)
+	}
+}
+
+func TestDecimalInvalid(t *testing.T) {
+	for _, s := range []string{"", "abc", `"1.5"`, "1 2", "[1]"} {
+		if _, err := NewDecimal(s); err == nil {
+			t.Errorf("Expected an error for %q", s)
+		}
+	}
+
+	var invoice Invoice
+	if err := json.Unmarshal([]byte(`{"total":"1.5"}`), &invoice); err == nil {
+		t.Error("Expected an error for a string total")
+	}
+}
@@ -0,0 +1,594 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Appliance struct {
+	Label   *string
+	Powered bool
+}
+
+// ApplianceLabelDefault is the default value of Label.
+const ApplianceLabelDefault string = "unnamed"
+
+// AppliancePoweredDefault is the default value of Powered.
+const AppliancePoweredDefault bool = true
+
+// GetLabel returns the value of Label, or its default when unset.
+func (m Appliance) GetLabel() string {
+	if m.Label != nil {
+		return *m.Label
+	}
+	return ApplianceLabelDefault
+}
+
+// NewAppliance returns a new Appliance holding the default values of its properties.
+func NewAppliance() Appliance {
+	var m Appliance
+	m.Powered = AppliancePoweredDefault
+	m.ApplyDefaults()
+	return m
+}
+
+// ApplyDefaults sets the optional properties left unset to their default value.
+func (m *Appliance) ApplyDefaults() {
+	if m.Label == nil {
+		m.Label = Ptr(ApplianceLabelDefault)
+	}
+}
+
+func (m *Appliance) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["label"]; ok {
+		if err := json.Unmarshal(v, &m.Label); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["powered"]; ok {
+		if err := json.Unmarshal(v, &m.Powered); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Appliance) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"powered": m.Powered,
+	}
+
+	if m.Label != nil {
+		obj["label"] = m.Label
+	}
+
+	return json.Marshal(obj)
+}
+
+type Celsius float32
+
+type FanSpeed string
+
+const (
+	FanSpeedLow    FanSpeed = "low"
+	FanSpeedMedium FanSpeed = "medium"
+	FanSpeedHigh   FanSpeed = "high"
+)
+
+func (f *FanSpeed) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = FanSpeed(v)
+	return nil
+}
+
+func (f FanSpeed) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(f))
+}
+
+type HeatingMode string
+
+const (
+	HeatingModeEco     HeatingMode = "eco"
+	HeatingModeComfort HeatingMode = "comfort"
+)
+
+func (f *HeatingMode) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = HeatingMode(v)
+	return nil
+}
+
+func (f HeatingMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(f))
+}
+
+type Rate Decimal
+
+func (s Rate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Decimal(s))
+}
+
+func (s *Rate) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*Decimal)(s))
+}
+
+type Thermostat struct {
+	Appliance
+	Target      *Celsius
+	FanSpeed    *FanSpeed
+	Mode        *HeatingMode
+	Hysteresis  *float64
+	Ratio       *float64
+	Zones       *int32
+	Offset      *int8
+	Channel     *uint16
+	Reading     *int64
+	Price       *Decimal
+	Tariff      *Rate
+	Interval    *time.Duration
+	InstalledAt *time.Time
+	SyncedAt    *time.Time
+	Holiday     *Date
+	WakeUp      *TimeOfDay
+	Manual      *url.URL
+	Note        Nullable[string]
+	Schedule    string
+}
+
+// ThermostatTargetDefault is the default value of Target.
+const ThermostatTargetDefault Celsius = 20.5
+
+// ThermostatFanSpeedDefault is the default value of FanSpeed.
+const ThermostatFanSpeedDefault FanSpeed = FanSpeedMedium
+
+// ThermostatModeDefault is the default value of Mode.
+const ThermostatModeDefault HeatingMode = "eco"
+
+// ThermostatHysteresisDefault is the default value of Hysteresis.
+const ThermostatHysteresisDefault float64 = 0.25
+
+// ThermostatRatioDefault is the default value of Ratio.
+const ThermostatRatioDefault float64 = 1.5
+
+// ThermostatZonesDefault is the default value of Zones.
+const ThermostatZonesDefault int32 = 1
+
+// ThermostatOffsetDefault is the default value of Offset.
+const ThermostatOffsetDefault int8 = -1
+
+// ThermostatChannelDefault is the default value of Channel.
+const ThermostatChannelDefault uint16 = 11
+
+// ThermostatReadingDefault is the default value of Reading.
+const ThermostatReadingDefault int64 = 42
+
+// ThermostatPriceDefault is the default value of Price.
+var ThermostatPriceDefault = mustDefault(NewDecimal("0.25"))
+
+// ThermostatTariffDefault is the default value of Tariff.
+var ThermostatTariffDefault = Rate(mustDefault(NewDecimal("0.15")))
+
+// ThermostatIntervalDefault is the default value of Interval.
+var ThermostatIntervalDefault = mustDefault(parseDurationISO8601("PT30S"))
+
+// ThermostatInstalledAtDefault is the default value of InstalledAt.
+var ThermostatInstalledAtDefault = mustDefault(time.Parse(time.RFC3339, "2024-01-01T09:00:00+09:00")).UTC()
+
+// ThermostatSyncedAtDefault is the default value of SyncedAt.
+var ThermostatSyncedAtDefault = mustDefault(time.Parse(time.RFC3339, "2024-01-01T08:00:00+08:00"))
+
+// ThermostatHolidayDefault is the default value of Holiday.
+var ThermostatHolidayDefault = mustDefault(ParseDate("2024-12-25"))
+
+// ThermostatWakeUpDefault is the default value of WakeUp.
+var ThermostatWakeUpDefault = mustDefault(ParseTimeOfDay("07:30:00"))
+
+// ThermostatManualDefault is the default value of Manual.
+var ThermostatManualDefault = *mustDefault(url.Parse("https://example.com/thermostat"))
+
+// ThermostatNoteDefault is the default value of Note.
+const ThermostatNoteDefault string = "none"
+
+// ThermostatScheduleDefault is the default value of Schedule.
+const ThermostatScheduleDefault string = "weekdays"
+
+// GetTarget returns the value of Target, or its default when unset.
+func (m Thermostat) GetTarget() Celsius {
+	if m.Target != nil {
+		return *m.Target
+	}
+	return ThermostatTargetDefault
+}
+
+// GetFanSpeed returns the value of FanSpeed, or its default when unset.
+func (m Thermostat) GetFanSpeed() FanSpeed {
+	if m.FanSpeed != nil {
+		return *m.FanSpeed
+	}
+	return ThermostatFanSpeedDefault
+}
+
+// GetMode returns the value of Mode, or its default when unset.
+func (m Thermostat) GetMode() HeatingMode {
+	if m.Mode != nil {
+		return *m.Mode
+	}
+	return ThermostatModeDefault
+}
+
+// GetHysteresis returns the value of Hysteresis, or its default when unset.
+func (m Thermostat) GetHysteresis() float64 {
+	if m.Hysteresis != nil {
+		return *m.Hysteresis
+	}
+	return ThermostatHysteresisDefault
+}
+
+// GetRatio returns the value of Ratio, or its default when unset.
+func (m Thermostat) GetRatio() float64 {
+	if m.Ratio != nil {
+		return *m.Ratio
+	}
+	return ThermostatRatioDefault
+}
+
+// GetZones returns the value of Zones, or its default when unset.
+func (m Thermostat) GetZones() int32 {
+	if m.Zones != nil {
+		return *m.Zones
+	}
+	return ThermostatZonesDefault
+}
+
+// GetOffset returns the value of Offset, or its default when unset.
+func (m Thermostat) GetOffset() int8 {
+	if m.Offset != nil {
+		return *m.Offset
+	}
+	return ThermostatOffsetDefault
+}
+
+// GetChannel returns the value of Channel, or its default when unset.
+func (m Thermostat) GetChannel() uint16 {
+	if m.Channel != nil {
+		return *m.Channel
+	}
+	return ThermostatChannelDefault
+}
+
+// GetReading returns the value of Reading, or its default when unset.
+func (m Thermostat) GetReading() int64 {
+	if m.Reading != nil {
+		return *m.Reading
+	}
+	return ThermostatReadingDefault
+}
+
+// GetPrice returns the value of Price, or its default when unset.
+func (m Thermostat) GetPrice() Decimal {
+	if m.Price != nil {
+		return *m.Price
+	}
+	return ThermostatPriceDefault
+}
+
+// GetTariff returns the value of Tariff, or its default when unset.
+func (m Thermostat) GetTariff() Rate {
+	if m.Tariff != nil {
+		return *m.Tariff
+	}
+	return ThermostatTariffDefault
+}
+
+// GetInterval returns the value of Interval, or its default when unset.
+func (m Thermostat) GetInterval() time.Duration {
+	if m.Interval != nil {
+		return *m.Interval
+	}
+	return ThermostatIntervalDefault
+}
+
+// GetInstalledAt returns the value of InstalledAt, or its default when unset.
+func (m Thermostat) GetInstalledAt() time.Time {
+	if m.InstalledAt != nil {
+		return *m.InstalledAt
+	}
+	return ThermostatInstalledAtDefault
+}
+
+// GetSyncedAt returns the value of SyncedAt, or its default when unset.
+func (m Thermostat) GetSyncedAt() time.Time {
+	if m.SyncedAt != nil {
+		return *m.SyncedAt
+	}
+	return ThermostatSyncedAtDefault
+}
+
+// GetHoliday returns the value of Holiday, or its default when unset.
+func (m Thermostat) GetHoliday() Date {
+	if m.Holiday != nil {
+		return *m.Holiday
+	}
+	return ThermostatHolidayDefault
+}
+
+// GetWakeUp returns the value of WakeUp, or its default when unset.
+func (m Thermostat) GetWakeUp() TimeOfDay {
+	if m.WakeUp != nil {
+		return *m.WakeUp
+	}
+	return ThermostatWakeUpDefault
+}
+
+// GetManual returns the value of Manual, or its default when unset.
+func (m Thermostat) GetManual() url.URL {
+	if m.Manual != nil {
+		return *m.Manual
+	}
+	return ThermostatManualDefault
+}
+
+// NewThermostat returns a new Thermostat holding the default values of its properties.
+func NewThermostat() Thermostat {
+	var m Thermostat
+	m.Powered = AppliancePoweredDefault
+	m.Schedule = ThermostatScheduleDefault
+	m.ApplyDefaults()
+	return m
+}
+
+// ApplyDefaults sets the optional properties left unset to their default value.
+func (m *Thermostat) ApplyDefaults() {
+	if m.Label == nil {
+		m.Label = Ptr(ApplianceLabelDefault)
+	}
+	if m.Target == nil {
+		m.Target = Ptr(ThermostatTargetDefault)
+	}
+	if m.FanSpeed == nil {
+		m.FanSpeed = Ptr(ThermostatFanSpeedDefault)
+	}
+	if m.Mode == nil {
+		m.Mode = Ptr(ThermostatModeDefault)
+	}
+	if m.Hysteresis == nil {
+		m.Hysteresis = Ptr(ThermostatHysteresisDefault)
+	}
+	if m.Ratio == nil {
+		m.Ratio = Ptr(ThermostatRatioDefault)
+	}
+	if m.Zones == nil {
+		m.Zones = Ptr(ThermostatZonesDefault)
+	}
+	if m.Offset == nil {
+		m.Offset = Ptr(ThermostatOffsetDefault)
+	}
+	if m.Channel == nil {
+		m.Channel = Ptr(ThermostatChannelDefault)
+	}
+	if m.Reading == nil {
+		m.Reading = Ptr(ThermostatReadingDefault)
+	}
+	if m.Price == nil {
+		m.Price = Ptr(ThermostatPriceDefault)
+	}
+	if m.Tariff == nil {
+		m.Tariff = Ptr(ThermostatTariffDefault)
+	}
+	if m.Interval == nil {
+		m.Interval = Ptr(ThermostatIntervalDefault)
+	}
+	if m.InstalledAt == nil {
+		m.InstalledAt = Ptr(ThermostatInstalledAtDefault)
+	}
+	if m.SyncedAt == nil {
+		m.SyncedAt = Ptr(ThermostatSyncedAtDefault)
+	}
+	if m.Holiday == nil {
+		m.Holiday = Ptr(ThermostatHolidayDefault)
+	}
+	if m.WakeUp == nil {
+		m.WakeUp = Ptr(ThermostatWakeUpDefault)
+	}
+	if m.Manual == nil {
+		m.Manual = Ptr(ThermostatManualDefault)
+	}
+	if !m.Note.IsSet() {
+		m.Note = SetNullable(ThermostatNoteDefault)
+	}
+}
+
+func (m *Thermostat) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["label"]; ok {
+		if err := json.Unmarshal(v, &m.Label); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["powered"]; ok {
+		if err := json.Unmarshal(v, &m.Powered); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["target"]; ok {
+		if err := json.Unmarshal(v, &m.Target); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["fanSpeed"]; ok {
+		if err := json.Unmarshal(v, &m.FanSpeed); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["mode"]; ok {
+		if err := json.Unmarshal(v, &m.Mode); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["hysteresis"]; ok {
+		if err := json.Unmarshal(v, &m.Hysteresis); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["ratio"]; ok {
+		if err := json.Unmarshal(v, &m.Ratio); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["zones"]; ok {
+		if err := json.Unmarshal(v, &m.Zones); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["offset"]; ok {
+		if err := json.Unmarshal(v, &m.Offset); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["channel"]; ok {
+		if err := json.Unmarshal(v, &m.Channel); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["reading"]; ok {
+		if err := json.Unmarshal(v, &m.Reading); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["price"]; ok {
+		if err := json.Unmarshal(v, &m.Price); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["tariff"]; ok {
+		if err := json.Unmarshal(v, &m.Tariff); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["interval"]; ok {
+		if err := unmarshalPointer(v, &m.Interval, unmarshalDurationInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["installedAt"]; ok {
+		if err := unmarshalPointer(v, &m.InstalledAt, unmarshalUtcDateTimeInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["syncedAt"]; ok {
+		if err := unmarshalPointer(v, &m.SyncedAt, unmarshalOffsetDateTimeInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["holiday"]; ok {
+		if err := unmarshalPointer(v, &m.Holiday, unmarshalPlainDateInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["wakeUp"]; ok {
+		if err := unmarshalPointer(v, &m.WakeUp, unmarshalPlainTimeInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["manual"]; ok {
+		if err := unmarshalPointer(v, &m.Manual, unmarshalURLInternal); err != nil {
+			return fmt.Errorf("manual: %w", err)
+		}
+	}
+	if v, ok := rawMsg["note"]; ok {
+		if err := json.Unmarshal(v, &m.Note); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["schedule"]; ok {
+		if err := json.Unmarshal(v, &m.Schedule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Thermostat) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"powered":  m.Powered,
+		"schedule": m.Schedule,
+	}
+
+	if m.Note.IsSet() {
+		obj["note"] = m.Note
+	}
+
+	if m.Label != nil {
+		obj["label"] = m.Label
+	}
+	if m.Target != nil {
+		obj["target"] = m.Target
+	}
+	if m.FanSpeed != nil {
+		obj["fanSpeed"] = m.FanSpeed
+	}
+	if m.Mode != nil {
+		obj["mode"] = m.Mode
+	}
+	if m.Hysteresis != nil {
+		obj["hysteresis"] = m.Hysteresis
+	}
+	if m.Ratio != nil {
+		obj["ratio"] = m.Ratio
+	}
+	if m.Zones != nil {
+		obj["zones"] = m.Zones
+	}
+	if m.Offset != nil {
+		obj["offset"] = m.Offset
+	}
+	if m.Channel != nil {
+		obj["channel"] = m.Channel
+	}
+	if m.Reading != nil {
+		obj["reading"] = m.Reading
+	}
+	if m.Price != nil {
+		obj["price"] = m.Price
+	}
+	if m.Tariff != nil {
+		obj["tariff"] = m.Tariff
+	}
+	if m.Interval != nil {
+		obj["interval"] = serializeDurationInternal(*m.Interval)
+	}
+	if m.InstalledAt != nil {
+		obj["installedAt"] = serializeUtcDateTimeInternal(*m.InstalledAt)
+	}
+	if m.SyncedAt != nil {
+		obj["syncedAt"] = serializeOffsetDateTimeInternal(*m.SyncedAt)
+	}
+	if m.Holiday != nil {
+		obj["holiday"] = serializePlainDateInternal(*m.Holiday)
+	}
+	if m.WakeUp != nil {
+		obj["wakeUp"] = serializePlainTimeInternal(*m.WakeUp)
+	}
+	if m.Manual != nil {
+		obj["manual"] = serializeURLInternal(*m.Manual)
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,95 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDefaultsAccessors(t *testing.T) {
+	var thermostat Thermostat
+	if err := json.Unmarshal([]byte(`{"target": 18, "schedule": "always"}`), &thermostat); err != nil {
+		t.Fatalf("Failed to unmarshal Thermostat: %v", err)
+	}
+	if thermostat.GetTarget() != 18 {
+		t.Errorf("Expected the decoded target but got %v", thermostat.GetTarget())
+	}
+	if thermostat.GetFanSpeed() != FanSpeedMedium || thermostat.GetMode() != HeatingModeEco {
+		t.Errorf("Unexpected fan speed %v or mode %v", thermostat.GetFanSpeed(), thermostat.GetMode())
+	}
+	if thermostat.GetLabel() != "unnamed" || thermostat.GetOffset() != -1 || thermostat.GetReading() != 42 {
+		t.Errorf("Unexpected defaults %q, %d and %d", thermostat.GetLabel(), thermostat.GetOffset(), thermostat.GetReading())
+	}
+	if thermostat.GetInterval() != 30*time.Second {
+		t.Errorf("Expected an interval of 30s but got %v", thermostat.GetInterval())
+	}
+	if installedAt := thermostat.GetInstalledAt(); installedAt != time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Expected the installation time in UTC but got %v", installedAt)
+	}
+	if !thermostat.GetSyncedAt().Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected synchronization time %v", thermostat.GetSyncedAt())
+	}
+	if thermostat.GetHoliday() != (Date{Year: 2024, Month: time.December, Day: 25}) {
+		t.Errorf("Unexpected holiday %v", thermostat.GetHoliday())
+	}
+	if thermostat.GetWakeUp() != (TimeOfDay{Hour: 7, Minute: 30}) {
+		t.Errorf("Unexpected wake up time %v", thermostat.GetWakeUp())
+	}
+	if manual := thermostat.GetManual(); manual.String() != "https://example.com/thermostat" {
+		t.Errorf("Unexpected manual %v", manual.String())
+	}
+	if price := thermostat.GetPrice(); price.String() != "0.25" {
+		t.Errorf("Expected a price of 0.25 but got %v", price)
+	}
+	if tariff := Decimal(thermostat.GetTariff()); tariff.String() != "0.15" {
+		t.Errorf("Expected a tariff of 0.15 but got %v", tariff)
+	}
+	// Accessors don't set the properties.
+	if thermostat.FanSpeed != nil || thermostat.Schedule != "always" {
+		t.Errorf("Unexpected thermostat %+v", thermostat)
+	}
+}
+
+func TestDefaultsNew(t *testing.T) {
+	thermostat := NewThermostat()
+	if !thermostat.Powered || thermostat.Schedule != ThermostatScheduleDefault {
+		t.Errorf("Expected the defaults of required properties but got %+v", thermostat)
+	}
+	if thermostat.Label == nil || *thermostat.Label != ApplianceLabelDefault {
+		t.Errorf("Expected the inherited default label but got %v", thermostat.Label)
+	}
+	if thermostat.Channel == nil || *thermostat.Channel != 11 || thermostat.Note.Value() != "none" {
+		t.Errorf("Unexpected thermostat %+v", thermostat)
+	}
+
+	data, err := json.Marshal(NewAppliance())
+	if err != nil {
+		t.Fatalf("Failed to marshal Appliance: %v", err)
+	}
+	if string(data) != `{"label":"unnamed","powered":true}` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+}
+
+func TestDefaultsApplyKeepsSetProperties(t *testing.T) {
+	thermostat := Thermostat{Zones: Ptr[int32](3), Note: NullNullable[string]()}
+	thermostat.ApplyDefaults()
+	if *thermostat.Zones != 3 || *thermostat.Ratio != 1.5 {
+		t.Errorf("Unexpected zones %d or ratio %v", *thermostat.Zones, *thermostat.Ratio)
+	}
+	// An explicit null is set, it isn't replaced by the default.
+	data, err := json.Marshal(thermostat)
+	if err != nil {
+		t.Fatalf("Failed to marshal Thermostat: %v", err)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		t.Fatal(err)
+	}
+	if note, ok := obj["note"]; !ok || note != nil {
+		t.Errorf("Expected a null note but got %v", obj["note"])
+	}
+	if obj["interval"] != "PT30S" || obj["installedAt"] != "2024-01-01T00:00:00Z" {
+		t.Errorf("Unexpected interval %v or installation time %v", obj["interval"], obj["installedAt"])
+	}
+}
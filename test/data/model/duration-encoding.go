@@ -0,0 +1,67 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type VoiceActivityDetection struct {
+	Window        time.Duration
+	Timeout       time.Duration
+	Threshold     time.Duration
+	PrefixPadding *time.Duration
+	Silence       Nullable[time.Duration]
+}
+
+func (m *VoiceActivityDetection) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["window"]; ok {
+		if err := unmarshalDurationInternal(v, &m.Window); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["timeout"]; ok {
+		if err := unmarshalDurationSecondsInternal(v, &m.Timeout); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["threshold"]; ok {
+		if err := unmarshalDurationSecondsFloatInternal(v, &m.Threshold); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["prefixPadding"]; ok {
+		if err := unmarshalPointer(v, &m.PrefixPadding, unmarshalDurationMillisecondsInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["silence"]; ok {
+		if err := unmarshalNullable(v, &m.Silence, unmarshalDurationMillisecondsFloatInternal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m VoiceActivityDetection) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"window":    serializeDurationInternal(m.Window),
+		"timeout":   serializeDurationSecondsInternal(m.Timeout),
+		"threshold": serializeDurationSecondsFloatInternal(m.Threshold),
+	}
+
+	if m.Silence.IsSet() {
+		obj["silence"] = serializeNullable(m.Silence, serializeDurationMillisecondsFloatInternal)
+	}
+
+	if m.PrefixPadding != nil {
+		obj["prefixPadding"] = serializeDurationMillisecondsInternal(*m.PrefixPadding)
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,122 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDurationISO8601Serialization(t *testing.T) {
+	cases := map[time.Duration]string{
+		0:                                       "PT0S",
+		90 * time.Second:                        "PT1M30S",
+		26 * time.Hour:                          "PT26H",
+		time.Hour + 5*time.Millisecond:          "PT1H0.005S",
+		-(2*time.Minute + 500*time.Microsecond): "-PT2M0.0005S",
+		time.Nanosecond:                         "PT0.000000001S",
+	}
+	for duration, expected := range cases {
+		if actual := serializeDurationInternal(duration); actual != expected {
+			t.Errorf("Expected %v to serialize as %s, got %s", duration, expected, actual)
+		}
+	}
+}
+
+func TestDurationISO8601Deserialization(t *testing.T) {
+	cases := map[string]time.Duration{
+		"PT0S":           0,
+		"PT1M30S":        90 * time.Second,
+		"P1DT2H":         26 * time.Hour,
+		"P2W":            14 * 24 * time.Hour,
+		"PT0.5S":         500 * time.Millisecond,
+		"PT1,25S":        1250 * time.Millisecond,
+		"PT0.5H":         30 * time.Minute,
+		"-PT1M":          -time.Minute,
+		"PT0.123456789S": 123456789 * time.Nanosecond,
+	}
+	for input, expected := range cases {
+		actual, err := parseDurationISO8601(input)
+		if err != nil {
+			t.Errorf("Failed to parse %s: %v", input, err)
+		} else if actual != expected {
+			t.Errorf("Expected %s to parse as %v, got %v", input, expected, actual)
+		}
+	}
+
+	for _, input := range []string{"", "P", "PT", "1M", "P1M", "P1Y", "PT1S1M", "PT1H1H", "PTS", "P1H", "PT1.5.5S"} {
+		if _, err := parseDurationISO8601(input); err == nil {
+			t.Errorf("Expected an error parsing %q", input)
+		}
+	}
+}
+
+func TestDurationISO8601Bounds(t *testing.T) {
+	cases := map[time.Duration]string{
+		math.MaxInt64: "PT2562047H47M16.854775807S",
+		math.MinInt64: "-PT2562047H47M16.854775808S",
+	}
+	for duration, expected := range cases {
+		if actual := serializeDurationInternal(duration); actual != expected {
+			t.Errorf("Expected %v to serialize as %s, got %s", duration, expected, actual)
+		}
+		if actual, err := parseDurationISO8601(expected); err != nil || actual != duration {
+			t.Errorf("Expected %s to parse as %v, got %v (%v)", expected, duration, actual, err)
+		}
+	}
+
+	for _, input := range []string{"PT2562047H47M16.854775808S", "PT2562047H60M", "P10000000W", "-PT2562048H"} {
+		if _, err := parseDurationISO8601(input); err == nil {
+			t.Errorf("Expected an overflow error parsing %q", input)
+		}
+	}
+}
+
+func TestDurationEncodingsSerialization(t *testing.T) {
+	vad := VoiceActivityDetection{
+		Window:        90 * time.Second,
+		Timeout:       2500 * time.Millisecond,
+		Threshold:     1500 * time.Millisecond,
+		PrefixPadding: Ptr(300 * time.Millisecond),
+		Silence:       SetNullable(1250 * time.Microsecond),
+	}
+
+	data, err := json.Marshal(vad)
+	if err != nil {
+		t.Fatalf("Failed to marshal VoiceActivityDetection: %v", err)
+	}
+
+	expected := `{"prefixPadding":300,"silence":1.25,"threshold":1.5,"timeout":2,"window":"PT1M30S"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(data))
+	}
+}
+
+func TestDurationEncodingsDeserialization(t *testing.T) {
+	data := []byte(`{"window":"PT1M30S","timeout":2,"threshold":0.29,"prefixPadding":300,"silence":null}`)
+
+	var vad VoiceActivityDetection
+	if err := json.Unmarshal(data, &vad); err != nil {
+		t.Fatalf("Failed to unmarshal VoiceActivityDetection: %v", err)
+	}
+
+	if vad.Window != 90*time.Second {
+		t.Errorf("Unexpected window %v", vad.Window)
+	}
+	if vad.Timeout != 2*time.Second {
+		t.Errorf("Unexpected timeout %v", vad.Timeout)
+	}
+	if vad.Threshold != 290*time.Millisecond {
+		t.Errorf("Unexpected threshold %v", vad.Threshold)
+	}
+	if vad.PrefixPadding == nil || *vad.PrefixPadding != 300*time.Millisecond {
+		t.Errorf("Unexpected prefixPadding %v", vad.PrefixPadding)
+	}
+	if !vad.Silence.IsSet() {
+		t.Error("Expected silence to be set to null")
+	}
+
+	if err := json.Unmarshal([]byte(`{"timeout":2.5}`), &vad); err == nil {
+		t.Error("Expected an error for a fractional timeout encoded as an integer")
+	}
+}
@@ -11,14 +11,14 @@ func TestDurationSerialization(t *testing.T) {
 	if err != nil {
 		t.Errorf("Failed to marshal Meeting: %v", err)
 	}
-	expected := `{"duration":"30s"}`
+	expected := `{"duration":"PT30S"}`
 	if string(data) != expected {
 		t.Errorf("Expected %v, got %v", expected, string(data))
 	}
 }
 
 func TestDurationDeserialization(t *testing.T) {
-	data := []byte(`{"duration":"30s"}`)
+	data := []byte(`{"duration":"PT30S"}`)
 	var meeting Meeting
 	err := meeting.UnmarshalJSON(data)
 	if err != nil {
@@ -0,0 +1,147 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+// PaintColor Colors available in the catalog.
+type PaintColor string
+
+const (
+	// PaintColorWhite Plain white, the default base.
+	PaintColorWhite PaintColor = "white"
+	PaintColorBlack PaintColor = "black"
+	PaintColorOchre PaintColor = "ochre"
+)
+
+func (f *PaintColor) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = PaintColor(v)
+	return nil
+}
+
+func (f PaintColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(f))
+}
+
+type ExtendedPaintColor string
+
+const (
+	// ExtendedPaintColorWhite Plain white, the default base.
+	ExtendedPaintColorWhite ExtendedPaintColor = "white"
+	ExtendedPaintColorBlack ExtendedPaintColor = "black"
+	ExtendedPaintColorOchre ExtendedPaintColor = "ochre"
+	ExtendedPaintColorTeal  ExtendedPaintColor = "teal"
+)
+
+func (f *ExtendedPaintColor) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = ExtendedPaintColor(v)
+	return nil
+}
+
+func (f ExtendedPaintColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(f))
+}
+
+type PaintPriority int64
+
+const (
+	PaintPriorityLow    PaintPriority = 1
+	PaintPriorityMedium PaintPriority = 5
+	PaintPriorityHigh   PaintPriority = 10
+)
+
+func (f *PaintPriority) UnmarshalJSON(data []byte) error {
+	var v int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = PaintPriority(v)
+	return nil
+}
+
+func (f PaintPriority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(f))
+}
+
+type Paint struct {
+	Color    PaintColor
+	Trim     *ExtendedPaintColor
+	Priority PaintPriority
+	Gloss    []PaintGloss
+}
+
+func (m Paint) Base() PaintColor {
+	return "white"
+}
+
+func (m *Paint) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["color"]; ok {
+		if err := json.Unmarshal(v, &m.Color); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["trim"]; ok {
+		if err := json.Unmarshal(v, &m.Trim); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["priority"]; ok {
+		if err := json.Unmarshal(v, &m.Priority); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["gloss"]; ok {
+		if err := json.Unmarshal(v, &m.Gloss); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Paint) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"color":    m.Color,
+		"priority": m.Priority,
+		"gloss":    m.Gloss,
+		"base":     "white",
+	}
+
+	if m.Trim != nil {
+		obj["trim"] = m.Trim
+	}
+
+	return json.Marshal(obj)
+}
+
+type PaintGloss float64
+
+const (
+	PaintGlossMatte PaintGloss = 0
+	PaintGlossSatin PaintGloss = 0.5
+	PaintGlossGloss PaintGloss = 1
+)
+
+func (f *PaintGloss) UnmarshalJSON(data []byte) error {
+	var v float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = PaintGloss(v)
+	return nil
+}
+
+func (f PaintGloss) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(f))
+}
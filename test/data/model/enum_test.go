@@ -0,0 +1,43 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumRoundTrip(t *testing.T) {
+	data := []byte(`{"color": "ochre", "trim": "teal", "priority": 5, "gloss": [0, 0.5], "base": "white"}`)
+
+	var paint Paint
+	if err := json.Unmarshal(data, &paint); err != nil {
+		t.Fatalf("Failed to unmarshal Paint: %v", err)
+	}
+
+	if paint.Color != PaintColorOchre {
+		t.Errorf("Expected color %q but got %q", PaintColorOchre, paint.Color)
+	}
+	if paint.Trim == nil || *paint.Trim != ExtendedPaintColorTeal {
+		t.Errorf("Expected trim %q but got %v", ExtendedPaintColorTeal, paint.Trim)
+	}
+	if paint.Priority != PaintPriorityMedium {
+		t.Errorf("Expected priority %d but got %d", PaintPriorityMedium, paint.Priority)
+	}
+	if len(paint.Gloss) != 2 || paint.Gloss[1] != PaintGlossSatin {
+		t.Errorf("Unexpected gloss %v", paint.Gloss)
+	}
+	if paint.Base() != PaintColorWhite {
+		t.Errorf("Expected base %q but got %q", PaintColorWhite, paint.Base())
+	}
+
+	out, err := json.Marshal(paint)
+	if err != nil {
+		t.Fatalf("Failed to marshal Paint: %v", err)
+	}
+	var roundTrip map[string]interface{}
+	if err := json.Unmarshal(out, &roundTrip); err != nil {
+		t.Fatalf("Failed to unmarshal marshaled Paint: %v", err)
+	}
+	if roundTrip["color"] != "ochre" || roundTrip["trim"] != "teal" || roundTrip["priority"] != float64(5) || roundTrip["base"] != "white" {
+		t.Errorf("Unexpected JSON %s", out)
+	}
+}
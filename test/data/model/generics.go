@@ -0,0 +1,372 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Page[T any] struct {
+	Items    []T
+	NextLink *string
+}
+
+func (m *Page[T]) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["items"]; ok {
+		if err := json.Unmarshal(v, &m.Items); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["nextLink"]; ok {
+		if err := json.Unmarshal(v, &m.NextLink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Page[T]) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"items": m.Items,
+	}
+
+	if m.NextLink != nil {
+		obj["nextLink"] = m.NextLink
+	}
+
+	return json.Marshal(obj)
+}
+
+type Envelope[T any, M any] struct {
+	Data T
+	Meta M
+}
+
+func (m *Envelope[T, M]) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["data"]; ok {
+		if err := json.Unmarshal(v, &m.Data); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["meta"]; ok {
+		if err := json.Unmarshal(v, &m.Meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Envelope[T, M]) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"data": m.Data,
+		"meta": m.Meta,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Batch[T any] struct {
+	Entries []T
+}
+
+func (m *Batch[T]) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["entries"]; ok {
+		if err := json.Unmarshal(v, &m.Entries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Batch[T]) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"entries": m.Entries,
+	}
+
+	return json.Marshal(obj)
+}
+
+type PageFigure struct {
+	Items    []Figure
+	NextLink *string
+}
+
+func (m *PageFigure) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["items"]; ok {
+		if err := unmarshalSlice(v, &m.Items, unmarshalWith(UnmarshalFigure)); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["nextLink"]; ok {
+		if err := json.Unmarshal(v, &m.NextLink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m PageFigure) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"items": m.Items,
+	}
+
+	if m.NextLink != nil {
+		obj["nextLink"] = m.NextLink
+	}
+
+	return json.Marshal(obj)
+}
+
+type FigureBatch struct {
+	Entries []Figure
+}
+
+func (m *FigureBatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["entries"]; ok {
+		if err := unmarshalSlice(v, &m.Entries, unmarshalWith(UnmarshalFigure)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m FigureBatch) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"entries": m.Entries,
+	}
+
+	return json.Marshal(obj)
+}
+
+type PageDuration struct {
+	Items    []time.Duration
+	NextLink *string
+}
+
+func (m *PageDuration) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["items"]; ok {
+		if err := unmarshalSlice(v, &m.Items, unmarshalDurationInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["nextLink"]; ok {
+		if err := json.Unmarshal(v, &m.NextLink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m PageDuration) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"items": serializeSlice(m.Items, serializeDurationInternal),
+	}
+
+	if m.NextLink != nil {
+		obj["nextLink"] = m.NextLink
+	}
+
+	return json.Marshal(obj)
+}
+
+type Catalog struct {
+	Products Page[Product]
+	Names    Page[string]
+	Featured Envelope[Product, map[string]string]
+	Figures  PageFigure
+	Batch    FigureBatch
+	Timings  PageDuration
+}
+
+func (m *Catalog) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["products"]; ok {
+		if err := json.Unmarshal(v, &m.Products); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["names"]; ok {
+		if err := json.Unmarshal(v, &m.Names); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["featured"]; ok {
+		if err := json.Unmarshal(v, &m.Featured); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["figures"]; ok {
+		if err := json.Unmarshal(v, &m.Figures); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["batch"]; ok {
+		if err := json.Unmarshal(v, &m.Batch); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["timings"]; ok {
+		if err := json.Unmarshal(v, &m.Timings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Catalog) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"products": m.Products,
+		"names":    m.Names,
+		"featured": m.Featured,
+		"figures":  m.Figures,
+		"batch":    m.Batch,
+		"timings":  m.Timings,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Product struct {
+	Name string
+}
+
+func (m *Product) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Product) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name": m.Name,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Circle struct {
+	Radius float64
+}
+
+func (m Circle) Kind() string {
+	return "circle"
+}
+
+func (m *Circle) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["radius"]; ok {
+		if err := json.Unmarshal(v, &m.Radius); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Circle) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"kind":   "circle",
+		"radius": m.Radius,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Square struct {
+	Side float64
+}
+
+func (m Square) Kind() string {
+	return "square"
+}
+
+func (m *Square) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["side"]; ok {
+		if err := json.Unmarshal(v, &m.Side); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Square) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"kind": "square",
+		"side": m.Side,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Figure interface {
+	Kind() string
+}
+
+func UnmarshalFigure(data []byte) (Figure, error) {
+	var typeCheck struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &typeCheck); err != nil {
+		return nil, err
+	}
+
+	var result Figure
+	switch typeCheck.Kind {
+	case "circle":
+		var v Circle
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		result = v
+
+	case "square":
+		var v Square
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		result = v
+
+	}
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGenericsDeserialization(t *testing.T) {
+	data := []byte(`{
+		"products": {"items": [{"name": "lamp"}], "nextLink": "/products?page=2"},
+		"names": {"items": ["a", "b"]},
+		"featured": {"data": {"name": "desk"}, "meta": {"source": "editor"}},
+		"figures": {"items": [{"kind": "circle", "radius": 1}, {"kind": "square", "side": 2}]},
+		"batch": {"entries": [{"kind": "square", "side": 3}]},
+		"timings": {"items": ["PT1S", "PT2M"]}
+	}`)
+
+	var catalog Catalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		t.Fatalf("Failed to unmarshal Catalog: %v", err)
+	}
+
+	if len(catalog.Products.Items) != 1 || catalog.Products.Items[0].Name != "lamp" {
+		t.Errorf("Unexpected products %+v", catalog.Products.Items)
+	}
+	if catalog.Products.NextLink == nil || *catalog.Products.NextLink != "/products?page=2" {
+		t.Errorf("Unexpected next link %v", catalog.Products.NextLink)
+	}
+	if len(catalog.Names.Items) != 2 || catalog.Names.NextLink != nil {
+		t.Errorf("Unexpected names %+v", catalog.Names)
+	}
+	if catalog.Featured.Data.Name != "desk" || catalog.Featured.Meta["source"] != "editor" {
+		t.Errorf("Unexpected featured %+v", catalog.Featured)
+	}
+	if circle, ok := catalog.Figures.Items[0].(Circle); !ok || circle.Radius != 1 {
+		t.Errorf("Expected a circle of radius 1 but got %v", catalog.Figures.Items[0])
+	}
+	if square, ok := catalog.Batch.Entries[0].(Square); !ok || square.Side != 3 {
+		t.Errorf("Expected a square of side 3 but got %v", catalog.Batch.Entries[0])
+	}
+	if len(catalog.Timings.Items) != 2 || catalog.Timings.Items[1] != 2*time.Minute {
+		t.Errorf("Unexpected timings %v", catalog.Timings.Items)
+	}
+}
+
+func TestGenericsSerialization(t *testing.T) {
+	page := Page[Product]{Items: []Product{{Name: "lamp"}}}
+	data, err := json.Marshal(page)
+	if err != nil {
+		t.Fatalf("Failed to marshal Page: %v", err)
+	}
+	if string(data) != `{"items":[{"name":"lamp"}]}` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	timings := PageDuration{Items: []time.Duration{90 * time.Second}}
+	data, err = json.Marshal(timings)
+	if err != nil {
+		t.Fatalf("Failed to marshal PageDuration: %v", err)
+	}
+	if string(data) != `{"items":["PT1M30S"]}` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+}
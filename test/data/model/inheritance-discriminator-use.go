@@ -1,8 +1,6 @@
 package modeltest
 
-import (
-	"encoding/json"
-)
+import "encoding/json"
 
 // This file is generated by the typespec compiler. Do not edit.
 type SmallBox struct {
@@ -0,0 +1,107 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+func (m *GeoPoint) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["lat"]; ok {
+		if err := json.Unmarshal(v, &m.Lat); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["lng"]; ok {
+		if err := json.Unmarshal(v, &m.Lng); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m GeoPoint) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"lat": m.Lat,
+		"lng": m.Lng,
+	}
+
+	return json.Marshal(obj)
+}
+
+type TravelMode string
+
+const (
+	TravelModeCar  TravelMode = "car"
+	TravelModeBike TravelMode = "bike"
+	TravelModeWalk TravelMode = "walk"
+)
+
+func (f *TravelMode) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = TravelMode(v)
+	return nil
+}
+
+func (f TravelMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(f))
+}
+
+type Venue struct {
+	Name     string
+	Location GeoPoint
+	Entrance *GeoPoint
+	Access   TravelMode
+}
+
+func (m *Venue) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["location"]; ok {
+		if err := json.Unmarshal(v, &m.Location); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["entrance"]; ok {
+		if err := json.Unmarshal(v, &m.Entrance); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["access"]; ok {
+		if err := json.Unmarshal(v, &m.Access); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Venue) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name":     m.Name,
+		"location": m.Location,
+		"access":   m.Access,
+	}
+
+	if m.Entrance != nil {
+		obj["entrance"] = m.Entrance
+	}
+
+	return json.Marshal(obj)
+}
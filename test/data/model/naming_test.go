@@ -0,0 +1,37 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAliasNamedTypes(t *testing.T) {
+	data := []byte(`{"name": "Hall", "location": {"lat": 48.85, "lng": 2.35}, "access": "bike"}`)
+
+	var venue Venue
+	if err := json.Unmarshal(data, &venue); err != nil {
+		t.Fatalf("Failed to unmarshal Venue: %v", err)
+	}
+	if venue.Location != (GeoPoint{Lat: 48.85, Lng: 2.35}) || venue.Access != TravelModeBike || venue.Entrance != nil {
+		t.Errorf("Unexpected venue %+v", venue)
+	}
+}
+
+func TestTypeAliases(t *testing.T) {
+	var badges BadgeList = []Badge{{Label: "first"}}
+	var award Award = badges[0]
+	var id BadgeId = "b-1"
+	wall := Wall{Badges: badges, Color: BadgeColorGold}
+
+	serialized, err := json.Marshal(wall)
+	if err != nil {
+		t.Fatalf("Failed to marshal Wall: %v", err)
+	}
+	expected := `{"badges":[{"label":"first"}],"color":"gold"}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+	if award.Label != "first" || id != "b-1" {
+		t.Errorf("Unexpected award %+v or id %q", award, id)
+	}
+}
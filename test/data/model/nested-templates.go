@@ -0,0 +1,150 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Depot struct {
+	Grid    [][]int32
+	Rows    []map[string]string
+	Lanes   map[string][]Vehicle
+	Timings []map[string][]time.Duration
+}
+
+func (m *Depot) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["grid"]; ok {
+		if err := json.Unmarshal(v, &m.Grid); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["rows"]; ok {
+		if err := json.Unmarshal(v, &m.Rows); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["lanes"]; ok {
+		if err := unmarshalMap(v, &m.Lanes, func(data []byte, v *[]Vehicle) error { return unmarshalSlice(data, v, unmarshalWith(UnmarshalVehicle)) }); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["timings"]; ok {
+		if err := unmarshalSlice(v, &m.Timings, func(data []byte, v *map[string][]time.Duration) error {
+			return unmarshalMap(data, v, func(data []byte, v *[]time.Duration) error { return unmarshalSlice(data, v, unmarshalDurationInternal) })
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Depot) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"grid":  m.Grid,
+		"rows":  m.Rows,
+		"lanes": m.Lanes,
+		"timings": serializeSlice(m.Timings, func(v map[string][]time.Duration) interface{} {
+			return serializeMap(v, func(v []time.Duration) interface{} { return serializeSlice(v, serializeDurationInternal) })
+		}),
+	}
+
+	return json.Marshal(obj)
+}
+
+type Car struct {
+	Seats int32
+}
+
+func (m Car) Kind() string {
+	return "car"
+}
+
+func (m *Car) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["seats"]; ok {
+		if err := json.Unmarshal(v, &m.Seats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Car) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"kind":  "car",
+		"seats": m.Seats,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Truck struct {
+	Payload float64
+}
+
+func (m Truck) Kind() string {
+	return "truck"
+}
+
+func (m *Truck) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["payload"]; ok {
+		if err := json.Unmarshal(v, &m.Payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Truck) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"kind":    "truck",
+		"payload": m.Payload,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Vehicle interface {
+	Kind() string
+}
+
+func UnmarshalVehicle(data []byte) (Vehicle, error) {
+	var typeCheck struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &typeCheck); err != nil {
+		return nil, err
+	}
+
+	var result Vehicle
+	switch typeCheck.Kind {
+	case "car":
+		var v Car
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		result = v
+
+	case "truck":
+		var v Truck
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		result = v
+
+	}
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNestedTemplatesRoundTrip(t *testing.T) {
+	data := `{"grid":[[1,2],[3]],"lanes":{"east":[{"kind":"car","seats":4},{"kind":"truck","payload":1.5}]},"rows":[{"a":"b"}],"timings":[{"rush":["PT5M","PT1H"]}]}`
+
+	var depot Depot
+	if err := json.Unmarshal([]byte(data), &depot); err != nil {
+		t.Fatalf("Failed to unmarshal Depot: %v", err)
+	}
+
+	if len(depot.Grid) != 2 || depot.Grid[0][1] != 2 || depot.Grid[1][0] != 3 {
+		t.Errorf("Unexpected grid %v", depot.Grid)
+	}
+	if depot.Rows[0]["a"] != "b" {
+		t.Errorf("Unexpected rows %v", depot.Rows)
+	}
+	lane := depot.Lanes["east"]
+	if len(lane) != 2 {
+		t.Fatalf("Expected 2 vehicles but got %v", lane)
+	}
+	if car, ok := lane[0].(Car); !ok || car.Seats != 4 {
+		t.Errorf("Expected a car with 4 seats but got %v", lane[0])
+	}
+	if truck, ok := lane[1].(Truck); !ok || truck.Payload != 1.5 {
+		t.Errorf("Expected a truck with payload 1.5 but got %v", lane[1])
+	}
+	if timings := depot.Timings[0]["rush"]; len(timings) != 2 || timings[0] != 5*time.Minute || timings[1] != time.Hour {
+		t.Errorf("Unexpected timings %v", depot.Timings)
+	}
+
+	serialized, err := json.Marshal(depot)
+	if err != nil {
+		t.Fatalf("Failed to marshal Depot: %v", err)
+	}
+	if string(serialized) != data {
+		t.Errorf("Expected %s, got %s", data, string(serialized))
+	}
+}
+
+func TestNestedTemplatesInvalidElement(t *testing.T) {
+	var depot Depot
+	if err := json.Unmarshal([]byte(`{"timings":[{"rush":["5m"]}]}`), &depot); err == nil {
+		t.Error("Expected an error for an invalid nested duration")
+	}
+}
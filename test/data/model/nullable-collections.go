@@ -0,0 +1,101 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Sensor struct {
+	Name string
+}
+
+func (m *Sensor) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Sensor) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name": m.Name,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Station struct {
+	Readings     []*float64
+	Calibrations map[string]*int32
+	Sensors      map[string]*Sensor
+	Delays       []*time.Duration
+	Backups      Nullable[[]Sensor]
+	History      *[]*[]string
+}
+
+func (m *Station) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["readings"]; ok {
+		if err := json.Unmarshal(v, &m.Readings); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["calibrations"]; ok {
+		if err := json.Unmarshal(v, &m.Calibrations); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["sensors"]; ok {
+		if err := json.Unmarshal(v, &m.Sensors); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["delays"]; ok {
+		if err := unmarshalSlice(v, &m.Delays, func(data []byte, v **time.Duration) error {
+			return unmarshalPointer(data, v, unmarshalDurationInternal)
+		}); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["backups"]; ok {
+		if err := json.Unmarshal(v, &m.Backups); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["history"]; ok {
+		if err := json.Unmarshal(v, &m.History); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Station) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"readings":     m.Readings,
+		"calibrations": m.Calibrations,
+		"sensors":      m.Sensors,
+		"delays":       serializeSlice(m.Delays, func(v *time.Duration) interface{} { return serializePointer(v, serializeDurationInternal) }),
+	}
+
+	if m.Backups.IsSet() {
+		obj["backups"] = m.Backups
+	}
+
+	if m.History != nil {
+		obj["history"] = m.History
+	}
+
+	return json.Marshal(obj)
+}
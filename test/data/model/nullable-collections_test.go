@@ -0,0 +1,74 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullableElementsDeserialization(t *testing.T) {
+	data := []byte(`{
+		"readings": [1.5, null, 3],
+		"calibrations": {"north": 2, "south": null},
+		"sensors": {"roof": {"name": "anemometer"}, "cellar": null},
+		"delays": ["PT1S", null],
+		"backups": null,
+		"history": [["a"], null]
+	}`)
+
+	var station Station
+	if err := json.Unmarshal(data, &station); err != nil {
+		t.Fatalf("Failed to unmarshal Station: %v", err)
+	}
+
+	if len(station.Readings) != 3 || *station.Readings[0] != 1.5 || station.Readings[1] != nil {
+		t.Errorf("Unexpected readings %v", station.Readings)
+	}
+	if south, ok := station.Calibrations["south"]; !ok || south != nil {
+		t.Errorf("Expected a null south calibration but got %v", station.Calibrations)
+	}
+	if cellar, ok := station.Sensors["cellar"]; !ok || cellar != nil || station.Sensors["roof"].Name != "anemometer" {
+		t.Errorf("Unexpected sensors %v", station.Sensors)
+	}
+	if len(station.Delays) != 2 || *station.Delays[0] != time.Second || station.Delays[1] != nil {
+		t.Errorf("Unexpected delays %v", station.Delays)
+	}
+	if !station.Backups.IsSet() || station.Backups.value != nil {
+		t.Errorf("Expected backups to be explicitly null")
+	}
+	if station.History == nil || len(*station.History) != 2 || (*station.History)[1] != nil {
+		t.Errorf("Unexpected history %v", station.History)
+	}
+}
+
+func TestNullableElementsSerialization(t *testing.T) {
+	station := Station{
+		Readings:     []*float64{Ptr(1.5), nil},
+		Calibrations: map[string]*int32{"south": nil},
+		Sensors:      map[string]*Sensor{"cellar": nil},
+		Delays:       []*time.Duration{nil, Ptr(time.Minute)},
+		Backups:      SetNullable([]Sensor{{Name: "spare"}}),
+	}
+
+	serialized, err := json.Marshal(station)
+	if err != nil {
+		t.Fatalf("Failed to marshal Station: %v", err)
+	}
+	expected := `{"backups":[{"name":"spare"}],"calibrations":{"south":null},"delays":[null,"PT1M"],"readings":[1.5,null],"sensors":{"cellar":null}}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+
+	station.Backups = NullNullable[[]Sensor]()
+	serialized, err = json.Marshal(station)
+	if err != nil {
+		t.Fatalf("Failed to marshal Station: %v", err)
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(serialized, &obj); err != nil {
+		t.Fatalf("Failed to unmarshal serialized Station: %v", err)
+	}
+	if string(obj["backups"]) != "null" {
+		t.Errorf("Expected explicit null backups but got %s", obj["backups"])
+	}
+}
@@ -20,7 +20,7 @@ func (f *HasNullableValueUnionFieldsSingleValue) UnmarshalJSON(data []byte) erro
 }
 
 func (f HasNullableValueUnionFieldsSingleValue) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
 
 type HasNullableValueUnionFieldsMultipleValues string
@@ -40,7 +40,7 @@ func (f *HasNullableValueUnionFieldsMultipleValues) UnmarshalJSON(data []byte) e
 }
 
 func (f HasNullableValueUnionFieldsMultipleValues) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
 
 type HasNullableValueUnionFields struct {
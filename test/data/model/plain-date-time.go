@@ -0,0 +1,57 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Shift struct {
+	Day      Date
+	StartsAt TimeOfDay
+	EndsAt   *TimeOfDay
+	BreakAt  Nullable[TimeOfDay]
+}
+
+func (m *Shift) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["day"]; ok {
+		if err := unmarshalPlainDateInternal(v, &m.Day); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["startsAt"]; ok {
+		if err := unmarshalPlainTimeInternal(v, &m.StartsAt); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["endsAt"]; ok {
+		if err := unmarshalPointer(v, &m.EndsAt, unmarshalPlainTimeInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["breakAt"]; ok {
+		if err := unmarshalNullable(v, &m.BreakAt, unmarshalPlainTimeInternal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Shift) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"day":      serializePlainDateInternal(m.Day),
+		"startsAt": serializePlainTimeInternal(m.StartsAt),
+	}
+
+	if m.BreakAt.IsSet() {
+		obj["breakAt"] = serializeNullable(m.BreakAt, serializePlainTimeInternal)
+	}
+
+	if m.EndsAt != nil {
+		obj["endsAt"] = serializePlainTimeInternal(*m.EndsAt)
+	}
+
+	return json.Marshal(obj)
+}
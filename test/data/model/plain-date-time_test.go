@@ -0,0 +1,74 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPlainDateTimeSerialization(t *testing.T) {
+	shift := Shift{
+		Day:      Date{Year: 2024, Month: time.February, Day: 29},
+		StartsAt: TimeOfDay{Hour: 9, Minute: 5},
+		EndsAt:   Ptr(TimeOfDay{Hour: 17, Minute: 30, Second: 15, Nanosecond: 250000000}),
+		BreakAt:  UnsetNullable[TimeOfDay](),
+	}
+
+	data, err := json.Marshal(shift)
+	if err != nil {
+		t.Fatalf("Failed to marshal Shift: %v", err)
+	}
+
+	expected := `{"day":"2024-02-29","endsAt":"17:30:15.25","startsAt":"09:05:00"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(data))
+	}
+}
+
+func TestPlainDateTimeDeserialization(t *testing.T) {
+	data := []byte(`{"day":"2024-02-29","startsAt":"09:05:00","endsAt":"17:30:15.250","breakAt":null}`)
+
+	var shift Shift
+	if err := json.Unmarshal(data, &shift); err != nil {
+		t.Fatalf("Failed to unmarshal Shift: %v", err)
+	}
+
+	if shift.Day != (Date{Year: 2024, Month: time.February, Day: 29}) {
+		t.Errorf("Unexpected day %v", shift.Day)
+	}
+	if shift.StartsAt != (TimeOfDay{Hour: 9, Minute: 5}) {
+		t.Errorf("Unexpected startsAt %v", shift.StartsAt)
+	}
+	if shift.EndsAt == nil || *shift.EndsAt != (TimeOfDay{Hour: 17, Minute: 30, Second: 15, Nanosecond: 250000000}) {
+		t.Errorf("Unexpected endsAt %v", shift.EndsAt)
+	}
+	if !shift.BreakAt.IsSet() {
+		t.Error("Expected breakAt to be set to null")
+	}
+}
+
+func TestPlainDateDeserializationInvalid(t *testing.T) {
+	for _, data := range []string{`{"day":"2023-02-29"}`, `{"day":"2024-2-1"}`, `{"startsAt":"25:00:00"}`} {
+		var shift Shift
+		if err := json.Unmarshal([]byte(data), &shift); err == nil {
+			t.Errorf("Expected an error for %s", data)
+		}
+	}
+}
+
+func TestCivilTypesConversion(t *testing.T) {
+	loc := time.FixedZone("", -3*60*60)
+	instant := time.Date(2024, time.December, 31, 23, 59, 58, 0, loc)
+
+	date := DateOf(instant)
+	timeOfDay := TimeOfDayOf(instant)
+	if date.String() != "2024-12-31" || timeOfDay.String() != "23:59:58" {
+		t.Errorf("Unexpected conversion %v %v", date, timeOfDay)
+	}
+	if !timeOfDay.On(date, loc).Equal(instant) {
+		t.Errorf("Expected %v, got %v", instant, timeOfDay.On(date, loc))
+	}
+	if !date.In(time.UTC).Equal(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected midnight %v", date.In(time.UTC))
+	}
+}
@@ -0,0 +1,110 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Coordinates struct {
+	Lat          float64
+	Lon          float64
+	unrecognized map[string]json.RawMessage
+}
+
+func (m *Coordinates) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["lat"]; ok {
+		if err := json.Unmarshal(v, &m.Lat); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["lon"]; ok {
+		if err := json.Unmarshal(v, &m.Lon); err != nil {
+			return err
+		}
+	}
+	m.unrecognized = nil
+	for key, v := range rawMsg {
+		switch key {
+		case "lat", "lon":
+			continue
+		}
+		if m.unrecognized == nil {
+			m.unrecognized = map[string]json.RawMessage{}
+		}
+		m.unrecognized[key] = v
+	}
+	return nil
+}
+
+func (m Coordinates) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"lat": m.Lat,
+		"lon": m.Lon,
+	}
+
+	for key, v := range m.unrecognized {
+		obj[key] = v
+	}
+
+	return json.Marshal(obj)
+}
+
+type Beacon struct {
+	Serial       string
+	Position     Coordinates
+	Label        *string
+	unrecognized map[string]json.RawMessage
+}
+
+func (m *Beacon) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["serial"]; ok {
+		if err := json.Unmarshal(v, &m.Serial); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["position"]; ok {
+		if err := json.Unmarshal(v, &m.Position); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["label"]; ok {
+		if err := json.Unmarshal(v, &m.Label); err != nil {
+			return err
+		}
+	}
+	m.unrecognized = nil
+	for key, v := range rawMsg {
+		switch key {
+		case "serial", "position", "label":
+			continue
+		}
+		if m.unrecognized == nil {
+			m.unrecognized = map[string]json.RawMessage{}
+		}
+		m.unrecognized[key] = v
+	}
+	return nil
+}
+
+func (m Beacon) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"serial":   m.Serial,
+		"position": m.Position,
+	}
+
+	if m.Label != nil {
+		obj["label"] = m.Label
+	}
+	for key, v := range m.unrecognized {
+		obj[key] = v
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,46 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPreserveUnknownPropertiesRoundTrip(t *testing.T) {
+	data := []byte(`{"serial": "B-7", "position": {"lat": 48.1, "lon": 11.5, "alt": {"value": 520, "unit": "m"}}, "battery": 87, "tags": ["roof"]}`)
+
+	var beacon Beacon
+	if err := json.Unmarshal(data, &beacon); err != nil {
+		t.Fatalf("Failed to unmarshal Beacon: %v", err)
+	}
+	if beacon.Serial != "B-7" || beacon.Position.Lat != 48.1 || beacon.Label != nil {
+		t.Errorf("Unexpected beacon %+v", beacon)
+	}
+
+	serialized, err := json.Marshal(beacon)
+	if err != nil {
+		t.Fatalf("Failed to marshal Beacon: %v", err)
+	}
+	expected := `{"battery":87,"position":{"alt":{"value":520,"unit":"m"},"lat":48.1,"lon":11.5},"serial":"B-7","tags":["roof"]}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+}
+
+func TestPreserveUnknownPropertiesDecodedAgain(t *testing.T) {
+	var beacon Beacon
+	if err := json.Unmarshal([]byte(`{"serial": "B-7", "battery": 87}`), &beacon); err != nil {
+		t.Fatalf("Failed to unmarshal Beacon: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"serial": "B-8"}`), &beacon); err != nil {
+		t.Fatalf("Failed to unmarshal Beacon: %v", err)
+	}
+
+	serialized, err := json.Marshal(beacon)
+	if err != nil {
+		t.Fatalf("Failed to marshal Beacon: %v", err)
+	}
+	expected := `{"position":{"lat":0,"lon":0},"serial":"B-8"}`
+	if string(serialized) != expected {
+		t.Errorf("Expected %s but got %s", expected, serialized)
+	}
+}
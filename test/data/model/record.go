@@ -0,0 +1,188 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Warehouse struct {
+	Stock            map[string]int32
+	Managers         map[string]Clerk
+	Shelves          map[string]Shelf
+	RestockIntervals map[string]time.Duration
+	Substitutes      map[string]*Clerk
+	Labels           Nullable[map[string]string]
+}
+
+func (m *Warehouse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["stock"]; ok {
+		if err := json.Unmarshal(v, &m.Stock); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["managers"]; ok {
+		if err := json.Unmarshal(v, &m.Managers); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["shelves"]; ok {
+		if err := unmarshalMap(v, &m.Shelves, unmarshalWith(UnmarshalShelf)); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["restockIntervals"]; ok {
+		if err := unmarshalMap(v, &m.RestockIntervals, unmarshalDurationInternal); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["substitutes"]; ok {
+		if err := json.Unmarshal(v, &m.Substitutes); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["labels"]; ok {
+		if err := json.Unmarshal(v, &m.Labels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Warehouse) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"stock":            m.Stock,
+		"managers":         m.Managers,
+		"shelves":          m.Shelves,
+		"restockIntervals": serializeMap(m.RestockIntervals, serializeDurationInternal),
+		"substitutes":      m.Substitutes,
+	}
+
+	if m.Labels.IsSet() {
+		obj["labels"] = m.Labels
+	}
+
+	return json.Marshal(obj)
+}
+
+type Clerk struct {
+	Name string
+}
+
+func (m *Clerk) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Clerk) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name": m.Name,
+	}
+
+	return json.Marshal(obj)
+}
+
+type WallShelf struct {
+	Height int32
+}
+
+func (m WallShelf) Kind() string {
+	return "wall"
+}
+
+func (m *WallShelf) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["height"]; ok {
+		if err := json.Unmarshal(v, &m.Height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m WallShelf) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"kind":   "wall",
+		"height": m.Height,
+	}
+
+	return json.Marshal(obj)
+}
+
+type FloorShelf struct {
+	Width int32
+}
+
+func (m FloorShelf) Kind() string {
+	return "floor"
+}
+
+func (m *FloorShelf) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["width"]; ok {
+		if err := json.Unmarshal(v, &m.Width); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m FloorShelf) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"kind":  "floor",
+		"width": m.Width,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Shelf interface {
+	Kind() string
+}
+
+func UnmarshalShelf(data []byte) (Shelf, error) {
+	var typeCheck struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &typeCheck); err != nil {
+		return nil, err
+	}
+
+	var result Shelf
+	switch typeCheck.Kind {
+	case "wall":
+		var v WallShelf
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		result = v
+
+	case "floor":
+		var v FloorShelf
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		result = v
+
+	}
+	return result, nil
+}
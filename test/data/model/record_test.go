@@ -0,0 +1,66 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestRecordDeserialization(t *testing.T) {
+	data := []byte(`{
+		"stock": {"bolts": 10, "nuts": 20},
+		"managers": {"north": {"name": "Ana"}},
+		"shelves": {"a1": {"kind": "wall", "height": 3}, "b2": {"kind": "floor", "width": 5}},
+		"restockIntervals": {"bolts": "P1D", "nuts": "PT12H"},
+		"substitutes": {"south": null, "east": {"name": "Bo"}},
+		"labels": null
+	}`)
+
+	var warehouse Warehouse
+	if err := json.Unmarshal(data, &warehouse); err != nil {
+		t.Fatalf("Failed to unmarshal Warehouse: %v", err)
+	}
+
+	if warehouse.Stock["bolts"] != 10 || warehouse.Stock["nuts"] != 20 {
+		t.Errorf("Unexpected stock %v", warehouse.Stock)
+	}
+	if warehouse.Managers["north"].Name != "Ana" {
+		t.Errorf("Unexpected managers %v", warehouse.Managers)
+	}
+	if shelf, ok := warehouse.Shelves["a1"].(WallShelf); !ok || shelf.Height != 3 {
+		t.Errorf("Expected a wall shelf of height 3 but got %v", warehouse.Shelves["a1"])
+	}
+	if shelf, ok := warehouse.Shelves["b2"].(FloorShelf); !ok || shelf.Width != 5 {
+		t.Errorf("Expected a floor shelf of width 5 but got %v", warehouse.Shelves["b2"])
+	}
+	if south, ok := warehouse.Substitutes["south"]; !ok || south != nil || warehouse.Substitutes["east"].Name != "Bo" {
+		t.Errorf("Unexpected substitutes %v", warehouse.Substitutes)
+	}
+	if warehouse.RestockIntervals["bolts"] != 24*time.Hour || warehouse.RestockIntervals["nuts"] != 12*time.Hour {
+		t.Errorf("Unexpected restock intervals %v", warehouse.RestockIntervals)
+	}
+	if !warehouse.Labels.IsSet() {
+		t.Error("Expected labels to be set to null")
+	}
+}
+
+func TestRecordSerialization(t *testing.T) {
+	warehouse := Warehouse{
+		Stock:            map[string]int32{"bolts": 10},
+		Managers:         map[string]Clerk{"north": {Name: "Ana"}},
+		Shelves:          map[string]Shelf{"a1": WallShelf{Height: 3}},
+		RestockIntervals: map[string]time.Duration{"bolts": 90 * time.Minute},
+		Substitutes:      map[string]*Clerk{"south": nil},
+		Labels:           SetNullable(map[string]string{"zone": "cold"}),
+	}
+
+	data, err := json.Marshal(warehouse)
+	if err != nil {
+		t.Fatalf("Failed to marshal Warehouse: %v", err)
+	}
+
+	expected := `{"labels":{"zone":"cold"},"managers":{"north":{"name":"Ana"}},"restockIntervals":{"bolts":"PT1H30M"},"shelves":{"a1":{"height":3,"kind":"wall"}},"stock":{"bolts":10},"substitutes":{"south":null}}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(data))
+	}
+}
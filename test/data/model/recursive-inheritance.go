@@ -0,0 +1,200 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type District struct {
+	Region
+	Code string
+}
+
+func (m *District) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["capital"]; ok {
+		if err := json.Unmarshal(v, &m.Capital); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["code"]; ok {
+		if err := json.Unmarshal(v, &m.Code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m District) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name":    m.Name,
+		"capital": m.Capital,
+		"code":    m.Code,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Region struct {
+	Name    string
+	Capital *District
+}
+
+func (m *Region) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["capital"]; ok {
+		if err := json.Unmarshal(v, &m.Capital); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Region) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name":    m.Name,
+		"capital": m.Capital,
+	}
+
+	return json.Marshal(obj)
+}
+
+type SegmentSplit struct {
+	Item0 Segment
+	Item1 Segment
+}
+
+func (t *SegmentSplit) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) != 2 {
+		return fmt.Errorf("expected 2 items for SegmentSplit but got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+		return fmt.Errorf("split[0]: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &t.Item1); err != nil {
+		return fmt.Errorf("split[1]: %w", err)
+	}
+	return nil
+}
+
+func (t SegmentSplit) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{t.Item0, t.Item1})
+}
+
+type Segment struct {
+	Length int32
+	Split  *SegmentSplit
+}
+
+func (m *Segment) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["length"]; ok {
+		if err := json.Unmarshal(v, &m.Length); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["split"]; ok {
+		if err := json.Unmarshal(v, &m.Split); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Segment) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"length": m.Length,
+		"split":  m.Split,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Link[T any, M any] struct {
+	Target T
+	Note   M
+}
+
+func (m *Link[T, M]) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["target"]; ok {
+		if err := json.Unmarshal(v, &m.Target); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["note"]; ok {
+		if err := json.Unmarshal(v, &m.Note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Link[T, M]) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"target": m.Target,
+		"note":   m.Note,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Chain struct {
+	Label string
+	Link  *Link[Chain, string]
+}
+
+func (m *Chain) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["label"]; ok {
+		if err := json.Unmarshal(v, &m.Label); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["link"]; ok {
+		if err := json.Unmarshal(v, &m.Link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Chain) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"label": m.Label,
+		"link":  m.Link,
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,60 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCycleThroughParent(t *testing.T) {
+	data := []byte(`{"name":"north","capital":{"name":"old town","capital":null,"code":"N1"}}`)
+
+	var region Region
+	if err := json.Unmarshal(data, &region); err != nil {
+		t.Fatalf("Failed to unmarshal Region: %v", err)
+	}
+	if region.Capital == nil || region.Capital.Code != "N1" || region.Capital.Capital != nil {
+		t.Fatalf("Unexpected capital %+v", region.Capital)
+	}
+
+	out, err := json.Marshal(region)
+	if err != nil {
+		t.Fatalf("Failed to marshal Region: %v", err)
+	}
+	expected := `{"capital":{"capital":null,"code":"N1","name":"old town"},"name":"north"}`
+	if string(out) != expected {
+		t.Errorf("Expected %s but got %s", expected, out)
+	}
+}
+
+func TestCycleThroughTuple(t *testing.T) {
+	data := []byte(`{"length":2,"split":[{"length":1,"split":null},{"length":1,"split":null}]}`)
+
+	var segment Segment
+	if err := json.Unmarshal(data, &segment); err != nil {
+		t.Fatalf("Failed to unmarshal Segment: %v", err)
+	}
+	if segment.Split == nil || segment.Split.Item1.Length != 1 || segment.Split.Item0.Split != nil {
+		t.Errorf("Unexpected split %+v", segment.Split)
+	}
+}
+
+func TestCycleThroughGenericInstance(t *testing.T) {
+	data := []byte(`{"label":"a","link":{"target":{"label":"b","link":null},"note":"next"}}`)
+
+	var chain Chain
+	if err := json.Unmarshal(data, &chain); err != nil {
+		t.Fatalf("Failed to unmarshal Chain: %v", err)
+	}
+	if chain.Link == nil || chain.Link.Note != "next" || chain.Link.Target.Label != "b" || chain.Link.Target.Link != nil {
+		t.Fatalf("Unexpected link %+v", chain.Link)
+	}
+
+	out, err := json.Marshal(chain)
+	if err != nil {
+		t.Fatalf("Failed to marshal Chain: %v", err)
+	}
+	expected := `{"label":"a","link":{"note":"next","target":{"label":"b","link":null}}}`
+	if string(out) != expected {
+		t.Errorf("Expected %s but got %s", expected, out)
+	}
+}
@@ -0,0 +1,202 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type TreeNode struct {
+	Value    int32
+	Children *[]TreeNode
+	Parent   *TreeNode
+}
+
+func (m *TreeNode) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["value"]; ok {
+		if err := json.Unmarshal(v, &m.Value); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["children"]; ok {
+		if err := json.Unmarshal(v, &m.Children); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["parent"]; ok {
+		if err := json.Unmarshal(v, &m.Parent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m TreeNode) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"value": m.Value,
+	}
+
+	if m.Children != nil {
+		obj["children"] = m.Children
+	}
+	if m.Parent != nil {
+		obj["parent"] = m.Parent
+	}
+
+	return json.Marshal(obj)
+}
+
+type Lesson struct {
+	Name   string
+	Course *Course
+}
+
+func (m *Lesson) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["course"]; ok {
+		if err := json.Unmarshal(v, &m.Course); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Lesson) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name":   m.Name,
+		"course": m.Course,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Course struct {
+	Title        string
+	Prerequisite Lesson
+}
+
+func (m *Course) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["title"]; ok {
+		if err := json.Unmarshal(v, &m.Title); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["prerequisite"]; ok {
+		if err := json.Unmarshal(v, &m.Prerequisite); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Course) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"title":        m.Title,
+		"prerequisite": m.Prerequisite,
+	}
+
+	return json.Marshal(obj)
+}
+
+type ListNode struct {
+	Value int32
+	Next  *ListNode
+}
+
+func (m *ListNode) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["value"]; ok {
+		if err := json.Unmarshal(v, &m.Value); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["next"]; ok {
+		if err := json.Unmarshal(v, &m.Next); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m ListNode) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"value": m.Value,
+		"next":  m.Next,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Canine struct {
+	Name string
+}
+
+func (m *Canine) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Canine) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name": m.Name,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Puppy struct {
+	Canine
+	Toy string
+}
+
+func (m *Puppy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["toy"]; ok {
+		if err := json.Unmarshal(v, &m.Toy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Puppy) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name": m.Name,
+		"toy":  m.Toy,
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,83 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRecursiveModels(t *testing.T) {
+	data := []byte(`{"value":1,"children":[{"value":2,"children":[{"value":3}]},{"value":4}]}`)
+
+	var root TreeNode
+	if err := json.Unmarshal(data, &root); err != nil {
+		t.Fatalf("Failed to unmarshal TreeNode: %v", err)
+	}
+	if root.Children == nil || len(*root.Children) != 2 {
+		t.Fatalf("Unexpected children %v", root.Children)
+	}
+	grandChildren := (*root.Children)[0].Children
+	if grandChildren == nil || (*grandChildren)[0].Value != 3 {
+		t.Errorf("Unexpected grand children %v", grandChildren)
+	}
+
+	out, err := json.Marshal(root)
+	if err != nil {
+		t.Fatalf("Failed to marshal TreeNode: %v", err)
+	}
+	expected := `{"children":[{"children":[{"value":3}],"value":2},{"value":4}],"value":1}`
+	if string(out) != expected {
+		t.Errorf("Expected %s but got %s", expected, out)
+	}
+}
+
+func TestMutuallyRecursiveModels(t *testing.T) {
+	data := []byte(`{"title":"algebra","prerequisite":{"name":"arithmetic","course":{"title":"basics","prerequisite":{"name":"counting","course":null}}}}`)
+
+	var course Course
+	if err := json.Unmarshal(data, &course); err != nil {
+		t.Fatalf("Failed to unmarshal Course: %v", err)
+	}
+	if course.Prerequisite.Course == nil || course.Prerequisite.Course.Prerequisite.Name != "counting" {
+		t.Errorf("Unexpected prerequisite %+v", course.Prerequisite)
+	}
+	if course.Prerequisite.Course.Prerequisite.Course != nil {
+		t.Errorf("Expected the chain to end with a nil course")
+	}
+}
+
+func TestDeepRecursiveModels(t *testing.T) {
+	const depth = 1000
+	data := strings.Repeat(`{"value":1,"next":`, depth) + "null" + strings.Repeat("}", depth)
+
+	var head ListNode
+	if err := json.Unmarshal([]byte(data), &head); err != nil {
+		t.Fatalf("Failed to unmarshal ListNode: %v", err)
+	}
+	count := 1
+	for node := head.Next; node != nil; node = node.Next {
+		count++
+	}
+	if count != depth {
+		t.Errorf("Expected %d nodes but got %d", depth, count)
+	}
+
+	out, err := json.Marshal(head)
+	if err != nil {
+		t.Fatalf("Failed to marshal ListNode: %v", err)
+	}
+	expected := strings.Repeat(`{"next":`, depth) + "null" + strings.Repeat(`,"value":1}`, depth)
+	if string(out) != expected {
+		t.Errorf("Expected the deep list to survive a round trip")
+	}
+}
+
+func TestBaseModelDeclaredAfterDerivedModel(t *testing.T) {
+	var puppy Puppy
+	if err := json.Unmarshal([]byte(`{"name":"rex","toy":"ball"}`), &puppy); err != nil {
+		t.Fatalf("Failed to unmarshal Puppy: %v", err)
+	}
+	if puppy.Name != "rex" || puppy.Toy != "ball" {
+		t.Errorf("Unexpected puppy %+v", puppy)
+	}
+}
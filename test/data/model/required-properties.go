@@ -0,0 +1,114 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Address struct {
+	Street string
+	City   string
+}
+
+func (m *Address) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	var missing []string
+	if v, ok := rawMsg["street"]; ok {
+		if err := json.Unmarshal(v, &m.Street); err != nil {
+			return err
+		}
+	} else {
+		missing = append(missing, "street")
+	}
+	if v, ok := rawMsg["city"]; ok {
+		if err := json.Unmarshal(v, &m.City); err != nil {
+			return err
+		}
+	} else {
+		missing = append(missing, "city")
+	}
+	if len(missing) > 0 {
+		return &MissingPropertiesError{Model: "Address", Properties: missing}
+	}
+	return nil
+}
+
+func (m Address) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"street": m.Street,
+		"city":   m.City,
+	}
+
+	return json.Marshal(obj)
+}
+
+type Shipment struct {
+	TrackingId string
+	Weight     float32
+	Recipient  Address
+	Note       *string
+	Signature  Nullable[string]
+}
+
+func (m *Shipment) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	var missing []string
+	if v, ok := rawMsg["trackingId"]; ok {
+		if err := json.Unmarshal(v, &m.TrackingId); err != nil {
+			return err
+		}
+	} else {
+		missing = append(missing, "trackingId")
+	}
+	if v, ok := rawMsg["weight"]; ok {
+		if err := json.Unmarshal(v, &m.Weight); err != nil {
+			return err
+		}
+	} else {
+		missing = append(missing, "weight")
+	}
+	if v, ok := rawMsg["recipient"]; ok {
+		if err := json.Unmarshal(v, &m.Recipient); err != nil {
+			return err
+		}
+	} else {
+		missing = append(missing, "recipient")
+	}
+	if v, ok := rawMsg["note"]; ok {
+		if err := json.Unmarshal(v, &m.Note); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["signature"]; ok {
+		if err := json.Unmarshal(v, &m.Signature); err != nil {
+			return err
+		}
+	}
+	if len(missing) > 0 {
+		return &MissingPropertiesError{Model: "Shipment", Properties: missing}
+	}
+	return nil
+}
+
+func (m Shipment) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"trackingId": m.TrackingId,
+		"weight":     m.Weight,
+		"recipient":  m.Recipient,
+	}
+
+	if m.Signature.IsSet() {
+		obj["signature"] = m.Signature
+	}
+
+	if m.Note != nil {
+		obj["note"] = m.Note
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,53 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRequiredPropertiesPresent(t *testing.T) {
+	data := []byte(`{"trackingId": "1Z999", "weight": 2.5, "recipient": {"street": "Main St 1", "city": "Springfield"}, "signature": null}`)
+
+	var shipment Shipment
+	if err := json.Unmarshal(data, &shipment); err != nil {
+		t.Fatalf("Failed to unmarshal Shipment: %v", err)
+	}
+	if shipment.TrackingId != "1Z999" || shipment.Recipient.City != "Springfield" || shipment.Note != nil {
+		t.Errorf("Unexpected shipment %+v", shipment)
+	}
+}
+
+func TestRequiredPropertiesMissing(t *testing.T) {
+	// Optional and nullable properties may be left out.
+	data := []byte(`{"recipient": {"street": "Main St 1", "city": "Springfield"}}`)
+
+	var shipment Shipment
+	err := json.Unmarshal(data, &shipment)
+	var missingErr *MissingPropertiesError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("Expected a MissingPropertiesError but got %v", err)
+	}
+	if missingErr.Model != "Shipment" || !reflect.DeepEqual(missingErr.Properties, []string{"trackingId", "weight"}) {
+		t.Errorf("Unexpected missing properties %+v", missingErr)
+	}
+	expected := "missing required properties of Shipment: trackingId, weight"
+	if err.Error() != expected {
+		t.Errorf("Expected %q but got %q", expected, err.Error())
+	}
+}
+
+func TestRequiredPropertiesMissingInNestedModel(t *testing.T) {
+	data := []byte(`{"trackingId": "1Z999", "weight": 2.5, "recipient": {}, "signature": "J. Doe"}`)
+
+	var shipment Shipment
+	err := json.Unmarshal(data, &shipment)
+	var missingErr *MissingPropertiesError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("Expected a MissingPropertiesError but got %v", err)
+	}
+	if missingErr.Model != "Address" || !reflect.DeepEqual(missingErr.Properties, []string{"street", "city"}) {
+		t.Errorf("Unexpected missing properties %+v", missingErr)
+	}
+}
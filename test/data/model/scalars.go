@@ -0,0 +1,150 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+// ResourceId Identifier of a resource.
+type ResourceId string
+
+type Percentage float32
+
+// Timeout Time allowed before giving up.
+type Timeout time.Duration
+
+func (s Timeout) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serializeDurationInternal(time.Duration(s)))
+}
+
+func (s *Timeout) UnmarshalJSON(data []byte) error {
+	return unmarshalDurationInternal(data, (*time.Duration)(s))
+}
+
+type TimeoutSeconds time.Duration
+
+func (s TimeoutSeconds) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serializeDurationSecondsInternal(time.Duration(s)))
+}
+
+func (s *TimeoutSeconds) UnmarshalJSON(data []byte) error {
+	return unmarshalDurationSecondsInternal(data, (*time.Duration)(s))
+}
+
+type ShortTimeout time.Duration
+
+func (s ShortTimeout) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serializeDurationSecondsInternal(time.Duration(s)))
+}
+
+func (s *ShortTimeout) UnmarshalJSON(data []byte) error {
+	return unmarshalDurationSecondsInternal(data, (*time.Duration)(s))
+}
+
+type Price Decimal
+
+func (s Price) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Decimal(s))
+}
+
+func (s *Price) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*Decimal)(s))
+}
+
+type CreatedAt time.Time
+
+func (s CreatedAt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serializeUtcDateTimeInternal(time.Time(s)))
+}
+
+func (s *CreatedAt) UnmarshalJSON(data []byte) error {
+	return unmarshalUtcDateTimeInternal(data, (*time.Time)(s))
+}
+
+type Job struct {
+	Id           ResourceId
+	Progress     Percentage
+	Timeout      Timeout
+	RetryAfter   *TimeoutSeconds
+	Limit        ShortTimeout
+	Heartbeat    Timeout
+	Cost         Price
+	CreatedAt    CreatedAt
+	Dependencies []ResourceId
+}
+
+func (m *Job) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["id"]; ok {
+		if err := json.Unmarshal(v, &m.Id); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["progress"]; ok {
+		if err := json.Unmarshal(v, &m.Progress); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["timeout"]; ok {
+		if err := json.Unmarshal(v, &m.Timeout); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["retryAfter"]; ok {
+		if err := json.Unmarshal(v, &m.RetryAfter); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["limit"]; ok {
+		if err := json.Unmarshal(v, &m.Limit); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["heartbeat"]; ok {
+		if err := func(data []byte, v *Timeout) error {
+			return unmarshalDurationMillisecondsInternal(data, (*time.Duration)(v))
+		}(v, &m.Heartbeat); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["cost"]; ok {
+		if err := json.Unmarshal(v, &m.Cost); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["createdAt"]; ok {
+		if err := json.Unmarshal(v, &m.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["dependencies"]; ok {
+		if err := json.Unmarshal(v, &m.Dependencies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Job) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"id":           m.Id,
+		"progress":     m.Progress,
+		"timeout":      m.Timeout,
+		"limit":        m.Limit,
+		"heartbeat":    func(v Timeout) interface{} { return serializeDurationMillisecondsInternal(time.Duration(v)) }(m.Heartbeat),
+		"cost":         m.Cost,
+		"createdAt":    m.CreatedAt,
+		"dependencies": m.Dependencies,
+	}
+
+	if m.RetryAfter != nil {
+		obj["retryAfter"] = m.RetryAfter
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,92 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestScalarsDeserialization(t *testing.T) {
+	data := []byte(`{
+		"id": "job-1",
+		"progress": 0.5,
+		"timeout": "PT1M",
+		"retryAfter": 30,
+		"limit": 90,
+		"heartbeat": 1500,
+		"cost": 12.50,
+		"createdAt": "2024-03-01T10:00:00Z",
+		"dependencies": ["job-0"]
+	}`)
+
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		t.Fatalf("Failed to unmarshal Job: %v", err)
+	}
+
+	if job.Id != "job-1" || job.Progress != 0.5 {
+		t.Errorf("Unexpected id %q or progress %v", job.Id, job.Progress)
+	}
+	if time.Duration(job.Timeout) != time.Minute {
+		t.Errorf("Expected timeout of a minute but got %v", time.Duration(job.Timeout))
+	}
+	if job.RetryAfter == nil || time.Duration(*job.RetryAfter) != 30*time.Second {
+		t.Errorf("Expected retry after 30s but got %v", job.RetryAfter)
+	}
+	if time.Duration(job.Limit) != 90*time.Second {
+		t.Errorf("Expected limit of 90s but got %v", time.Duration(job.Limit))
+	}
+	if time.Duration(job.Heartbeat) != 1500*time.Millisecond {
+		t.Errorf("Expected heartbeat of 1.5s but got %v", time.Duration(job.Heartbeat))
+	}
+	if Decimal(job.Cost).String() != "12.50" {
+		t.Errorf("Expected cost 12.50 but got %s", Decimal(job.Cost).String())
+	}
+	if !time.Time(job.CreatedAt).Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected creation time %v", time.Time(job.CreatedAt))
+	}
+	if len(job.Dependencies) != 1 || job.Dependencies[0] != "job-0" {
+		t.Errorf("Unexpected dependencies %v", job.Dependencies)
+	}
+}
+
+func TestScalarsSerialization(t *testing.T) {
+	retryAfter := TimeoutSeconds(30 * time.Second)
+	cost, err := NewDecimal("12.50")
+	if err != nil {
+		t.Fatalf("Failed to create decimal: %v", err)
+	}
+	job := Job{
+		Id:         "job-1",
+		Timeout:    Timeout(time.Minute),
+		RetryAfter: &retryAfter,
+		Limit:      ShortTimeout(90 * time.Second),
+		Heartbeat:  Timeout(1500 * time.Millisecond),
+		Cost:       Price(cost),
+		CreatedAt:  CreatedAt(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		t.Fatalf("Failed to marshal Job: %v", err)
+	}
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Failed to unmarshal marshaled Job: %v", err)
+	}
+
+	expected := map[string]string{
+		"id":         `"job-1"`,
+		"timeout":    `"PT1M"`,
+		"retryAfter": `30`,
+		"limit":      `90`,
+		"heartbeat":  `1500`,
+		"cost":       `12.50`,
+		"createdAt":  `"2024-03-01T10:00:00Z"`,
+	}
+	for key, value := range expected {
+		if string(result[key]) != value {
+			t.Errorf("Expected %s to be %s but got %s", key, value, result[key])
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Ledger struct {
+	Balance  int64
+	Limit    uint64
+	Sequence *int64
+	Small    int8
+	Total    Decimal
+	History  []int64
+	Count    int64
+}
+
+func (m *Ledger) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["balance"]; ok {
+		if err := unmarshalIntStringInternal[int64](v, &m.Balance); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["limit"]; ok {
+		if err := unmarshalUintStringInternal[uint64](v, &m.Limit); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["sequence"]; ok {
+		if err := unmarshalPointer(v, &m.Sequence, unmarshalIntStringInternal[int64]); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["small"]; ok {
+		if err := unmarshalIntStringInternal[int8](v, &m.Small); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["total"]; ok {
+		if err := unmarshalDecimalStringInternal(v, &m.Total); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["history"]; ok {
+		if err := unmarshalSlice(v, &m.History, unmarshalIntStringInternal[int64]); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["count"]; ok {
+		if err := json.Unmarshal(v, &m.Count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Ledger) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"balance": serializeIntStringInternal[int64](m.Balance),
+		"limit":   serializeUintStringInternal[uint64](m.Limit),
+		"small":   serializeIntStringInternal[int8](m.Small),
+		"total":   serializeDecimalStringInternal(m.Total),
+		"history": serializeSlice(m.History, serializeIntStringInternal[int64]),
+		"count":   m.Count,
+	}
+
+	if m.Sequence != nil {
+		obj["sequence"] = serializeIntStringInternal[int64](*m.Sequence)
+	}
+
+	return json.Marshal(obj)
+}
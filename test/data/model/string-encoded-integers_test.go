@@ -0,0 +1,57 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestStringEncodedIntegersRoundTrip(t *testing.T) {
+	data := []byte(`{"balance":"9007199254740993","limit":"18446744073709551615","sequence":"-42","small":"-128","total":"12.50","history":["1","-2"],"count":3}`)
+
+	var ledger Ledger
+	if err := json.Unmarshal(data, &ledger); err != nil {
+		t.Fatalf("Failed to unmarshal Ledger: %v", err)
+	}
+
+	if ledger.Balance != 9007199254740993 {
+		t.Errorf("Expected balance 9007199254740993 but got %d", ledger.Balance)
+	}
+	if ledger.Limit != math.MaxUint64 {
+		t.Errorf("Expected limit %d but got %d", uint64(math.MaxUint64), ledger.Limit)
+	}
+	if ledger.Sequence == nil || *ledger.Sequence != -42 {
+		t.Errorf("Expected sequence -42 but got %v", ledger.Sequence)
+	}
+	if ledger.Small != -128 || ledger.Total.String() != "12.50" {
+		t.Errorf("Unexpected small %d or total %s", ledger.Small, ledger.Total)
+	}
+	if len(ledger.History) != 2 || ledger.History[1] != -2 {
+		t.Errorf("Unexpected history %v", ledger.History)
+	}
+
+	out, err := json.Marshal(ledger)
+	if err != nil {
+		t.Fatalf("Failed to marshal Ledger: %v", err)
+	}
+	if string(out) != `{"balance":"9007199254740993","count":3,"history":["1","-2"],"limit":"18446744073709551615","sequence":"-42","small":"-128","total":"12.50"}` {
+		t.Errorf("Unexpected JSON %s", out)
+	}
+}
+
+func TestStringEncodedIntegersInvalid(t *testing.T) {
+	cases := map[string]string{
+		"out of range":  `{"small":"128"}`,
+		"negative uint": `{"limit":"-1"}`,
+		"not a number":  `{"balance":"12abc"}`,
+		"not a string":  `{"balance":12}`,
+		"fraction":      `{"balance":"1.5"}`,
+		"bad decimal":   `{"total":"abc"}`,
+	}
+	for name, data := range cases {
+		var ledger Ledger
+		if err := json.Unmarshal([]byte(data), &ledger); err == nil {
+			t.Errorf("%s: expected an error for %s", name, data)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+type RouteOrigin struct {
+	Item0 string
+	Item1 int32
+	Item2 Waypoint
+}
+
+func (t *RouteOrigin) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) != 3 {
+		return fmt.Errorf("expected 3 items for RouteOrigin but got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+		return fmt.Errorf("origin[0]: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &t.Item1); err != nil {
+		return fmt.Errorf("origin[1]: %w", err)
+	}
+	if err := json.Unmarshal(items[2], &t.Item2); err != nil {
+		return fmt.Errorf("origin[2]: %w", err)
+	}
+	return nil
+}
+
+func (t RouteOrigin) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{t.Item0, t.Item1, t.Item2})
+}
+
+type Waypoint struct {
+	Name string
+}
+
+func (m *Waypoint) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["name"]; ok {
+		if err := json.Unmarshal(v, &m.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Waypoint) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"name": m.Name,
+	}
+
+	return json.Marshal(obj)
+}
+
+type RouteBounds struct {
+	Item0 RouteBoundsItem0
+	Item1 RouteBoundsItem1
+}
+
+func (t *RouteBounds) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) != 2 {
+		return fmt.Errorf("expected 2 items for RouteBounds but got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &t.Item1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t RouteBounds) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{t.Item0, t.Item1})
+}
+
+type RouteBoundsItem0 struct {
+	Item0 float64
+	Item1 float64
+}
+
+func (t *RouteBoundsItem0) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) != 2 {
+		return fmt.Errorf("expected 2 items for RouteBoundsItem0 but got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+		return fmt.Errorf("bounds[0][0]: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &t.Item1); err != nil {
+		return fmt.Errorf("bounds[0][1]: %w", err)
+	}
+	return nil
+}
+
+func (t RouteBoundsItem0) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{t.Item0, t.Item1})
+}
+
+type RouteBoundsItem1 struct {
+	Item0 float64
+	Item1 float64
+}
+
+func (t *RouteBoundsItem1) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) != 2 {
+		return fmt.Errorf("expected 2 items for RouteBoundsItem1 but got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+		return fmt.Errorf("bounds[1][0]: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &t.Item1); err != nil {
+		return fmt.Errorf("bounds[1][1]: %w", err)
+	}
+	return nil
+}
+
+func (t RouteBoundsItem1) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{t.Item0, t.Item1})
+}
+
+type Route struct {
+	Origin RouteOrigin
+	Legs   []RouteLegsItem
+	Bounds *RouteBounds
+}
+
+func (m *Route) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["origin"]; ok {
+		if err := json.Unmarshal(v, &m.Origin); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["legs"]; ok {
+		if err := json.Unmarshal(v, &m.Legs); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["bounds"]; ok {
+		if err := json.Unmarshal(v, &m.Bounds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Route) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"origin": m.Origin,
+		"legs":   m.Legs,
+	}
+
+	if m.Bounds != nil {
+		obj["bounds"] = m.Bounds
+	}
+
+	return json.Marshal(obj)
+}
+
+type RouteLegsItem struct {
+	Item0 Waypoint
+	Item1 time.Duration
+}
+
+func (t *RouteLegsItem) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) != 2 {
+		return fmt.Errorf("expected 2 items for RouteLegsItem but got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+		return fmt.Errorf("legs[0]: %w", err)
+	}
+	if err := unmarshalDurationInternal(items[1], &t.Item1); err != nil {
+		return fmt.Errorf("legs[1]: %w", err)
+	}
+	return nil
+}
+
+func (t RouteLegsItem) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{t.Item0, serializeDurationInternal(t.Item1)})
+}
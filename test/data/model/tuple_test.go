@@ -0,0 +1,57 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTupleRoundTrip(t *testing.T) {
+	data := []byte(`{"origin":["home",3,{"name":"gate"}],"legs":[[{"name":"bridge"},"PT5M"]],"bounds":[[0.5,1.5],[2,3]]}`)
+
+	var route Route
+	if err := json.Unmarshal(data, &route); err != nil {
+		t.Fatalf("Failed to unmarshal Route: %v", err)
+	}
+
+	if route.Origin.Item0 != "home" || route.Origin.Item1 != 3 || route.Origin.Item2.Name != "gate" {
+		t.Errorf("Unexpected origin %+v", route.Origin)
+	}
+	if len(route.Legs) != 1 || route.Legs[0].Item0.Name != "bridge" || route.Legs[0].Item1 != 5*time.Minute {
+		t.Errorf("Unexpected legs %+v", route.Legs)
+	}
+	if route.Bounds == nil || route.Bounds.Item0.Item1 != 1.5 || route.Bounds.Item1.Item0 != 2 {
+		t.Errorf("Unexpected bounds %+v", route.Bounds)
+	}
+
+	out, err := json.Marshal(route)
+	if err != nil {
+		t.Fatalf("Failed to marshal Route: %v", err)
+	}
+	if string(out) != `{"bounds":[[0.5,1.5],[2,3]],"legs":[[{"name":"bridge"},"PT5M"]],"origin":["home",3,{"name":"gate"}]}` {
+		t.Errorf("Unexpected JSON %s", out)
+	}
+}
+
+func TestTupleWrongLength(t *testing.T) {
+	var origin RouteOrigin
+	if err := json.Unmarshal([]byte(`["home",3]`), &origin); err == nil {
+		t.Error("Expected an error for a tuple with missing items")
+	}
+	if err := json.Unmarshal([]byte(`["home",3,{},4]`), &origin); err == nil {
+		t.Error("Expected an error for a tuple with extra items")
+	}
+}
+
+func TestTupleItemError(t *testing.T) {
+	var route Route
+	err := json.Unmarshal([]byte(`{"origin":["home","three",{"name":"gate"}],"legs":[]}`), &route)
+	if err == nil || !strings.HasPrefix(err.Error(), "origin[1]: ") {
+		t.Errorf("Expected an error naming origin[1] but got %v", err)
+	}
+	err = json.Unmarshal([]byte(`{"origin":["home",3,{"name":"gate"}],"legs":[],"bounds":[[0.5,1.5],[2,"x"]]}`), &route)
+	if err == nil || !strings.HasPrefix(err.Error(), "bounds[1][1]: ") {
+		t.Errorf("Expected an error naming bounds[1][1] but got %v", err)
+	}
+}
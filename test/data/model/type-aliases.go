@@ -0,0 +1,88 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Badge struct {
+	Label string
+}
+
+func (m *Badge) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["label"]; ok {
+		if err := json.Unmarshal(v, &m.Label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Badge) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"label": m.Label,
+	}
+
+	return json.Marshal(obj)
+}
+
+type BadgeColor string
+
+const (
+	BadgeColorGold   BadgeColor = "gold"
+	BadgeColorSilver BadgeColor = "silver"
+)
+
+func (f *BadgeColor) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = BadgeColor(v)
+	return nil
+}
+
+func (f BadgeColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(f))
+}
+
+type Wall struct {
+	Badges []Badge
+	Color  BadgeColor
+}
+
+func (m *Wall) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["badges"]; ok {
+		if err := json.Unmarshal(v, &m.Badges); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["color"]; ok {
+		if err := json.Unmarshal(v, &m.Color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Wall) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"badges": m.Badges,
+		"color":  m.Color,
+	}
+
+	return json.Marshal(obj)
+}
+
+type BadgeList = []Badge
+
+type BadgeId = string
+
+type Award = Badge
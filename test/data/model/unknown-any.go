@@ -0,0 +1,42 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type AuditEntry struct {
+	Action  string
+	Details any
+}
+
+// DecodeDetails decodes the value of Details into v.
+func (m AuditEntry) DecodeDetails(v interface{}) error {
+	return decodeUnknownInternal(m.Details, v)
+}
+
+func (m *AuditEntry) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["action"]; ok {
+		if err := json.Unmarshal(v, &m.Action); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["details"]; ok {
+		if err := json.Unmarshal(v, &m.Details); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m AuditEntry) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"action":  m.Action,
+		"details": m.Details,
+	}
+
+	return json.Marshal(obj)
+}
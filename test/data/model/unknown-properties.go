@@ -0,0 +1,293 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Size struct {
+	Width  float64
+	Height float64
+}
+
+func (m *Size) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["width"]; ok {
+		if err := json.Unmarshal(v, &m.Width); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["height"]; ok {
+		if err := json.Unmarshal(v, &m.Height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Size) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"width":  m.Width,
+		"height": m.Height,
+	}
+
+	return json.Marshal(obj)
+}
+
+func (m Size) collectUnknown(data []byte, path string, unknown *[]string) {
+	rawMsg := rawEntries(data)
+	for key := range rawMsg {
+		switch key {
+		case "width", "height":
+		default:
+			*unknown = append(*unknown, joinPath(path, key))
+		}
+	}
+
+}
+
+type Parcel struct {
+	Weight float64
+	Size   *Size
+}
+
+func (m *Parcel) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["weight"]; ok {
+		if err := json.Unmarshal(v, &m.Weight); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["size"]; ok {
+		if err := json.Unmarshal(v, &m.Size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Parcel) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"weight": m.Weight,
+	}
+
+	if m.Size != nil {
+		obj["size"] = m.Size
+	}
+
+	return json.Marshal(obj)
+}
+
+func (m Parcel) collectUnknown(data []byte, path string, unknown *[]string) {
+	rawMsg := rawEntries(data)
+	for key := range rawMsg {
+		switch key {
+		case "weight", "size":
+		default:
+			*unknown = append(*unknown, joinPath(path, key))
+		}
+	}
+	if v, ok := rawMsg["size"]; ok {
+		if m.Size != nil {
+			(*m.Size).collectUnknown(v, joinPath(path, "size"), unknown)
+		}
+	}
+}
+
+type Marking interface {
+	Type() string
+}
+
+type MarkingSize struct {
+	Value Size
+}
+
+func (v MarkingSize) Type() string {
+	return "Size"
+}
+
+type MarkingString struct {
+	Value string
+}
+
+func (v MarkingString) Type() string {
+	return "string"
+}
+
+func UnmarshalMarking(data []byte) (Marking, error) {
+	var err error
+
+	var size Size
+	if err = json.Unmarshal(data, &size); err == nil {
+		return MarkingSize{Value: size}, nil
+	}
+
+	var string string
+	if err = json.Unmarshal(data, &string); err == nil {
+		return MarkingString{Value: string}, nil
+	}
+
+	return nil, err
+}
+
+func (v MarkingSize) collectUnknown(data []byte, path string, unknown *[]string) {
+	v.Value.collectUnknown(data, path, unknown)
+}
+
+type Pallet struct {
+	Id      string
+	Parcels []Parcel
+	Sizes   map[string]Size
+	Marking Marking
+}
+
+func (m *Pallet) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["id"]; ok {
+		if err := json.Unmarshal(v, &m.Id); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["parcels"]; ok {
+		if err := json.Unmarshal(v, &m.Parcels); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["sizes"]; ok {
+		if err := json.Unmarshal(v, &m.Sizes); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["marking"]; ok {
+		value, err := UnmarshalMarking(v)
+		if err != nil {
+			return err
+		}
+		m.Marking = value
+	}
+	if unknown := findUnknownProperties(data, m); len(unknown) > 0 {
+		return &UnknownPropertiesError{Model: "Pallet", Paths: unknown}
+	}
+	return nil
+}
+
+func (m Pallet) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"id":      m.Id,
+		"parcels": m.Parcels,
+		"sizes":   m.Sizes,
+		"marking": m.Marking,
+	}
+
+	return json.Marshal(obj)
+}
+
+func (m Pallet) collectUnknown(data []byte, path string, unknown *[]string) {
+	rawMsg := rawEntries(data)
+	for key := range rawMsg {
+		switch key {
+		case "id", "parcels", "sizes", "marking":
+		default:
+			*unknown = append(*unknown, joinPath(path, key))
+		}
+	}
+	if v, ok := rawMsg["parcels"]; ok {
+		for i0, r0 := range rawItems(v) {
+			if i0 < len(m.Parcels) {
+				m.Parcels[i0].collectUnknown(r0, indexPath(joinPath(path, "parcels"), i0), unknown)
+			}
+		}
+	}
+	if v, ok := rawMsg["sizes"]; ok {
+		for k0, r0 := range rawEntries(v) {
+			if e0, ok := m.Sizes[k0]; ok {
+				e0.collectUnknown(r0, keyPath(joinPath(path, "sizes"), k0), unknown)
+			}
+		}
+	}
+	if v, ok := rawMsg["marking"]; ok {
+		collectUnknownValue(v, joinPath(path, "marking"), unknown, m.Marking)
+	}
+}
+
+type Consignment struct {
+	Reference         string
+	Parcels           []Parcel
+	Marking           *Marking
+	unknownProperties []string
+}
+
+// UnknownProperties returns the paths of the JSON properties unknown to Consignment or its values when decoded.
+func (m Consignment) UnknownProperties() []string {
+	return m.unknownProperties
+}
+
+func (m *Consignment) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["reference"]; ok {
+		if err := json.Unmarshal(v, &m.Reference); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["parcels"]; ok {
+		if err := json.Unmarshal(v, &m.Parcels); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["marking"]; ok {
+		value, err := UnmarshalMarking(v)
+		if err != nil {
+			return err
+		}
+		m.Marking = &value
+	}
+	m.unknownProperties = findUnknownProperties(data, m)
+	return nil
+}
+
+func (m Consignment) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"reference": m.Reference,
+		"parcels":   m.Parcels,
+	}
+
+	if m.Marking != nil {
+		obj["marking"] = m.Marking
+	}
+
+	return json.Marshal(obj)
+}
+
+func (m Consignment) collectUnknown(data []byte, path string, unknown *[]string) {
+	rawMsg := rawEntries(data)
+	for key := range rawMsg {
+		switch key {
+		case "reference", "parcels", "marking":
+		default:
+			*unknown = append(*unknown, joinPath(path, key))
+		}
+	}
+	if v, ok := rawMsg["parcels"]; ok {
+		for i0, r0 := range rawItems(v) {
+			if i0 < len(m.Parcels) {
+				m.Parcels[i0].collectUnknown(r0, indexPath(joinPath(path, "parcels"), i0), unknown)
+			}
+		}
+	}
+	if v, ok := rawMsg["marking"]; ok {
+		if m.Marking != nil {
+			collectUnknownValue(v, joinPath(path, "marking"), unknown, *m.Marking)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUnknownPropertiesIgnored(t *testing.T) {
+	data := []byte(`{"weight": 1.5, "color": "red", "size": {"width": 1, "height": 2, "depth": 3}}`)
+
+	var parcel Parcel
+	if err := json.Unmarshal(data, &parcel); err != nil {
+		t.Fatalf("Failed to unmarshal Parcel: %v", err)
+	}
+	if parcel.Weight != 1.5 || parcel.Size == nil || parcel.Size.Height != 2 {
+		t.Errorf("Unexpected parcel %+v", parcel)
+	}
+}
+
+func TestUnknownPropertiesStrict(t *testing.T) {
+	data := []byte(`{
+		"id": "P-1",
+		"parcels": [{"weight": 1}, {"weight": 2, "fragile": true, "size": {"width": 1, "height": 1, "depth": 1}}],
+		"sizes": {"base": {"width": 1, "height": 1, "unit": "m"}},
+		"marking": {"width": 2, "height": 3, "color": "red"},
+		"owner": "ops"
+	}`)
+
+	var pallet Pallet
+	err := json.Unmarshal(data, &pallet)
+	var unknownErr *UnknownPropertiesError
+	if !errors.As(err, &unknownErr) {
+		t.Fatalf("Expected an UnknownPropertiesError but got %v", err)
+	}
+	expected := []string{
+		"marking.color",
+		"owner",
+		"parcels[1].fragile",
+		"parcels[1].size.depth",
+		`sizes["base"].unit`,
+	}
+	if unknownErr.Model != "Pallet" || !reflect.DeepEqual(unknownErr.Paths, expected) {
+		t.Errorf("Expected unknown properties %v of Pallet but got %+v", expected, unknownErr)
+	}
+}
+
+func TestUnknownPropertiesStrictKnown(t *testing.T) {
+	data := []byte(`{"id": "P-1", "parcels": [], "sizes": {}, "marking": "keep dry"}`)
+
+	var pallet Pallet
+	if err := json.Unmarshal(data, &pallet); err != nil {
+		t.Fatalf("Failed to unmarshal Pallet: %v", err)
+	}
+	if marking, ok := pallet.Marking.(MarkingString); !ok || marking.Value != "keep dry" {
+		t.Errorf("Unexpected marking %+v", pallet.Marking)
+	}
+}
+
+func TestUnknownPropertiesWarn(t *testing.T) {
+	data := []byte(`{"reference": "C-1", "parcels": [{"weight": 1, "color": "red"}], "marking": {"width": 1, "height": 1, "depth": 1}, "carrier": "rail"}`)
+
+	var consignment Consignment
+	if err := json.Unmarshal(data, &consignment); err != nil {
+		t.Fatalf("Failed to unmarshal Consignment: %v", err)
+	}
+	if consignment.Reference != "C-1" || len(consignment.Parcels) != 1 {
+		t.Errorf("Unexpected consignment %+v", consignment)
+	}
+	expected := []string{"carrier", "marking.depth", "parcels[0].color"}
+	if !reflect.DeepEqual(consignment.UnknownProperties(), expected) {
+		t.Errorf("Expected unknown properties %v but got %v", expected, consignment.UnknownProperties())
+	}
+}
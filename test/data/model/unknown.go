@@ -0,0 +1,64 @@
+package modeltest
+
+import "encoding/json"
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Webhook struct {
+	Event       string
+	Payload     json.RawMessage
+	Metadata    *json.RawMessage
+	Attachments []json.RawMessage
+}
+
+// DecodePayload decodes the value of Payload into v.
+func (m Webhook) DecodePayload(v interface{}) error {
+	return decodeUnknownInternal(m.Payload, v)
+}
+
+// DecodeMetadata decodes the value of Metadata into v.
+func (m Webhook) DecodeMetadata(v interface{}) error {
+	return decodeUnknownInternal(m.Metadata, v)
+}
+
+func (m *Webhook) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["event"]; ok {
+		if err := json.Unmarshal(v, &m.Event); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["payload"]; ok {
+		if err := json.Unmarshal(v, &m.Payload); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["metadata"]; ok {
+		if err := json.Unmarshal(v, &m.Metadata); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["attachments"]; ok {
+		if err := json.Unmarshal(v, &m.Attachments); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Webhook) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"event":       m.Event,
+		"payload":     m.Payload,
+		"attachments": m.Attachments,
+	}
+
+	if m.Metadata != nil {
+		obj["metadata"] = m.Metadata
+	}
+
+	return json.Marshal(obj)
+}
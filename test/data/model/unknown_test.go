@@ -0,0 +1,66 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnknownRoundTrip(t *testing.T) {
+	data := []byte(`{"event":"push","payload":{"ref":"main","commits":[1,2]},"metadata":{"retries":3},"attachments":["a",{"b":true}]}`)
+
+	var webhook Webhook
+	if err := json.Unmarshal(data, &webhook); err != nil {
+		t.Fatalf("Failed to unmarshal Webhook: %v", err)
+	}
+
+	if string(webhook.Payload) != `{"ref":"main","commits":[1,2]}` {
+		t.Errorf("Expected the raw payload but got %s", webhook.Payload)
+	}
+	if len(webhook.Attachments) != 2 || string(webhook.Attachments[1]) != `{"b":true}` {
+		t.Errorf("Unexpected attachments %s", webhook.Attachments)
+	}
+
+	var payload struct {
+		Ref     string `json:"ref"`
+		Commits []int  `json:"commits"`
+	}
+	if err := webhook.DecodePayload(&payload); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if payload.Ref != "main" || len(payload.Commits) != 2 {
+		t.Errorf("Unexpected decoded payload %+v", payload)
+	}
+
+	metadata, err := DecodeUnknown[map[string]int](webhook.Metadata)
+	if err != nil {
+		t.Fatalf("Failed to decode metadata: %v", err)
+	}
+	if metadata["retries"] != 3 {
+		t.Errorf("Unexpected decoded metadata %v", metadata)
+	}
+
+	out, err := json.Marshal(webhook)
+	if err != nil {
+		t.Fatalf("Failed to marshal Webhook: %v", err)
+	}
+	if string(out) != `{"attachments":["a",{"b":true}],"event":"push","metadata":{"retries":3},"payload":{"ref":"main","commits":[1,2]}}` {
+		t.Errorf("Unexpected JSON %s", out)
+	}
+}
+
+func TestUnknownAnyDecode(t *testing.T) {
+	var entry AuditEntry
+	if err := json.Unmarshal([]byte(`{"action":"delete","details":{"id":42}}`), &entry); err != nil {
+		t.Fatalf("Failed to unmarshal AuditEntry: %v", err)
+	}
+
+	var details struct {
+		Id int `json:"id"`
+	}
+	if err := entry.DecodeDetails(&details); err != nil {
+		t.Fatalf("Failed to decode details: %v", err)
+	}
+	if details.Id != 42 {
+		t.Errorf("Expected id 42 but got %d", details.Id)
+	}
+}
@@ -0,0 +1,77 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// This file is generated by the typespec compiler. Do not edit.
+
+type Website url.URL
+
+func (s Website) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serializeURLInternal(url.URL(s)))
+}
+
+func (s *Website) UnmarshalJSON(data []byte) error {
+	return unmarshalURLInternal(data, (*url.URL)(s))
+}
+
+type Profile struct {
+	Homepage url.URL
+	Avatar   *url.URL
+	Mirrors  []url.URL
+	Nickname string
+	Blog     *Website
+}
+
+func (m *Profile) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return err
+	}
+	if v, ok := rawMsg["homepage"]; ok {
+		if err := unmarshalURLInternal(v, &m.Homepage); err != nil {
+			return fmt.Errorf("homepage: %w", err)
+		}
+	}
+	if v, ok := rawMsg["avatar"]; ok {
+		if err := unmarshalPointer(v, &m.Avatar, unmarshalURLInternal); err != nil {
+			return fmt.Errorf("avatar: %w", err)
+		}
+	}
+	if v, ok := rawMsg["mirrors"]; ok {
+		if err := unmarshalSlice(v, &m.Mirrors, unmarshalURLInternal); err != nil {
+			return fmt.Errorf("mirrors: %w", err)
+		}
+	}
+	if v, ok := rawMsg["nickname"]; ok {
+		if err := json.Unmarshal(v, &m.Nickname); err != nil {
+			return err
+		}
+	}
+	if v, ok := rawMsg["blog"]; ok {
+		if err := json.Unmarshal(v, &m.Blog); err != nil {
+			return fmt.Errorf("blog: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m Profile) MarshalJSON() ([]byte, error) {
+	obj := map[string]interface{}{
+		"homepage": serializeURLInternal(m.Homepage),
+		"mirrors":  serializeSlice(m.Mirrors, serializeURLInternal),
+		"nickname": m.Nickname,
+	}
+
+	if m.Avatar != nil {
+		obj["avatar"] = serializeURLInternal(*m.Avatar)
+	}
+	if m.Blog != nil {
+		obj["blog"] = m.Blog
+	}
+
+	return json.Marshal(obj)
+}
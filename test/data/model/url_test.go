@@ -0,0 +1,49 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestURLRoundTrip(t *testing.T) {
+	data := []byte(`{"homepage":"HTTPS://example.com/a b?q=1","avatar":"https://cdn.example.com/me.png","mirrors":["https://mirror.example.com"],"nickname":"ana"}`)
+
+	var profile Profile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		t.Fatalf("Failed to unmarshal Profile: %v", err)
+	}
+
+	if profile.Homepage.Host != "example.com" || profile.Homepage.Path != "/a b" {
+		t.Errorf("Unexpected homepage %v", profile.Homepage)
+	}
+	if profile.Avatar == nil || profile.Avatar.Path != "/me.png" {
+		t.Errorf("Unexpected avatar %v", profile.Avatar)
+	}
+
+	out, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatalf("Failed to marshal Profile: %v", err)
+	}
+	if string(out) != `{"avatar":"https://cdn.example.com/me.png","homepage":"https://example.com/a%20b?q=1","mirrors":["https://mirror.example.com"],"nickname":"ana"}` {
+		t.Errorf("Unexpected JSON %s", out)
+	}
+}
+
+func TestURLInvalid(t *testing.T) {
+	cases := map[string]string{
+		"homepage": `{"homepage":"/relative/path"}`,
+		"avatar":   `{"avatar":"https://exa mple.com"}`,
+		"mirrors":  `{"mirrors":["https://ok.example.com","::"]}`,
+		"blog":     `{"blog":"blog.example.com"}`,
+	}
+	for property, data := range cases {
+		var profile Profile
+		err := json.Unmarshal([]byte(data), &profile)
+		if err == nil {
+			t.Errorf("Expected an error for %s", data)
+		} else if !strings.HasPrefix(err.Error(), property+": ") {
+			t.Errorf("Expected the error to name %s but got %v", property, err)
+		}
+	}
+}
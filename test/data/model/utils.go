@@ -1,7 +1,18 @@
 package modeltest
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -48,26 +59,855 @@ func (o *Nullable[T]) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &o.value)
 }
 
+func serializeNullable[T, U any](n Nullable[T], serialize func(T) U) interface{} {
+	if n.value == nil {
+		return nil
+	}
+	return serialize(*n.value)
+}
+
+func unmarshalNullable[T any](data []byte, n *Nullable[T], unmarshal func([]byte, *T) error) error {
+	if string(data) == "null" {
+		*n = NullNullable[T]()
+		return nil
+	}
+	var v T
+	if err := unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = SetNullable(v)
+	return nil
+}
 func Ptr[T any](v T) *T {
 	return &v
 }
 
-func serializeDurationInternal(v time.Duration) string {
+// mustDefault returns the value parsed from a default of the spec, which the spec compiler already checked.
+func mustDefault[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+const plainDateLayout = "2006-01-02"
+const plainTimeLayout = "15:04:05.999999999"
+
+// Date is a calendar date without a time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the Date on which t occurs in t's location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a date in the YYYY-MM-DD format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(plainDateLayout, s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+func (d Date) String() string {
+	return d.In(time.UTC).Format(plainDateLayout)
+}
+
+// In returns the time at midnight of d in the given location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// TimeOfDay is a wall clock time without a date or time zone.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf returns the TimeOfDay at which t occurs in t's location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// ParseTimeOfDay parses a time in the HH:MM:SS[.fff] format.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := time.Parse(plainTimeLayout, s)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayOf(t), nil
+}
+
+func (t TimeOfDay) String() string {
+	return t.On(Date{Year: 0, Month: time.January, Day: 1}, time.UTC).Format(plainTimeLayout)
+}
+
+// On returns the time at t on the date d in the given location.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+func serializePlainDateInternal(v Date) string {
 	return v.String()
 }
 
+func unmarshalPlainDateInternal(data []byte, date *Date) error {
+	var dateString string
+	if err := json.Unmarshal(data, &dateString); err != nil {
+		return err
+	}
+
+	v, err := ParseDate(dateString)
+	if err != nil {
+		return err
+	}
+	*date = v
+
+	return nil
+}
+
+func serializePlainTimeInternal(v TimeOfDay) string {
+	return v.String()
+}
+
+func unmarshalPlainTimeInternal(data []byte, timeOfDay *TimeOfDay) error {
+	var timeString string
+	if err := json.Unmarshal(data, &timeString); err != nil {
+		return err
+	}
+
+	v, err := ParseTimeOfDay(timeString)
+	if err != nil {
+		return err
+	}
+	*timeOfDay = v
+
+	return nil
+}
+
+// Decimal is an arbitrary precision decimal number that keeps the exact text of its JSON representation.
+type Decimal struct {
+	value string
+}
+
+// NewDecimal returns the Decimal represented by s, which must be a valid JSON number.
+func NewDecimal(s string) (Decimal, error) {
+	if !json.Valid([]byte(s)) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	var d Decimal
+	if err := d.UnmarshalJSON([]byte(s)); err != nil {
+		return Decimal{}, err
+	}
+	return d, nil
+}
+
+// DecimalFromRat returns the Decimal representation of r rounded to prec digits after the decimal point.
+func DecimalFromRat(r *big.Rat, prec int) Decimal {
+	return Decimal{value: r.FloatString(prec)}
+}
+
+func (d Decimal) String() string {
+	if d.value == "" {
+		return "0"
+	}
+	return d.value
+}
+
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() (float64, error) {
+	return strconv.ParseFloat(d.String(), 64)
+}
+
+// Rat returns the exact value of d.
+func (d Decimal) Rat() *big.Rat {
+	r, _ := new(big.Rat).SetString(d.String())
+	return r
+}
+
+// compareDecimal compares d to the number written in bound, returning -1, 0 or +1 like big.Rat.Cmp.
+func compareDecimal(d Decimal, bound string) int {
+	b, _ := new(big.Rat).SetString(bound)
+	return d.Rat().Cmp(b)
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) == 0 || (data[0] != '-' && (data[0] < '0' || data[0] > '9')) {
+		return fmt.Errorf("invalid decimal %s", data)
+	}
+	d.value = string(data)
+	return nil
+}
+
+func serializeDecimalStringInternal(v Decimal) string {
+	return v.String()
+}
+
+func unmarshalDecimalStringInternal(data []byte, decimal *Decimal) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	v, err := NewDecimal(s)
+	if err != nil {
+		return err
+	}
+	*decimal = v
+
+	return nil
+}
+
+// DecodeUnknown decodes the value of a property typed unknown into a value of type T.
+func DecodeUnknown[T any](value interface{}) (T, error) {
+	var result T
+	err := decodeUnknownInternal(value, &result)
+	return result, err
+}
+
+func decodeUnknownInternal(value interface{}, v interface{}) error {
+	raw, ok := value.(json.RawMessage)
+	if !ok {
+		var err error
+		if raw, err = json.Marshal(value); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
+func serializePointer[T, U any](v *T, serialize func(T) U) interface{} {
+	if v == nil {
+		return nil
+	}
+	return serialize(*v)
+}
+
+func unmarshalPointer[T any](data []byte, ptr **T, unmarshal func([]byte, *T) error) error {
+	if string(data) == "null" {
+		*ptr = nil
+		return nil
+	}
+	var v T
+	if err := unmarshal(data, &v); err != nil {
+		return err
+	}
+	*ptr = &v
+	return nil
+}
+
+func serializeSlice[T, U any](values []T, serialize func(T) U) []U {
+	if values == nil {
+		return nil
+	}
+	result := make([]U, len(values))
+	for i, v := range values {
+		result[i] = serialize(v)
+	}
+	return result
+}
+
+func unmarshalSlice[T any](data []byte, values *[]T, unmarshal func([]byte, *T) error) error {
+	var rawValues []json.RawMessage
+	if err := json.Unmarshal(data, &rawValues); err != nil {
+		return err
+	}
+	if rawValues == nil {
+		*values = nil
+		return nil
+	}
+	result := make([]T, len(rawValues))
+	for i, v := range rawValues {
+		if err := unmarshal(v, &result[i]); err != nil {
+			return err
+		}
+	}
+	*values = result
+	return nil
+}
+
+func serializeMap[T, U any](values map[string]T, serialize func(T) U) map[string]U {
+	if values == nil {
+		return nil
+	}
+	result := make(map[string]U, len(values))
+	for k, v := range values {
+		result[k] = serialize(v)
+	}
+	return result
+}
+
+func unmarshalMap[T any](data []byte, values *map[string]T, unmarshal func([]byte, *T) error) error {
+	var rawValues map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawValues); err != nil {
+		return err
+	}
+	if rawValues == nil {
+		*values = nil
+		return nil
+	}
+	result := make(map[string]T, len(rawValues))
+	for k, v := range rawValues {
+		var value T
+		if err := unmarshal(v, &value); err != nil {
+			return err
+		}
+		result[k] = value
+	}
+	*values = result
+	return nil
+}
+
+func unmarshalWith[T any](unmarshal func([]byte) (T, error)) func([]byte, *T) error {
+	return func(data []byte, v *T) error {
+		value, err := unmarshal(data)
+		if err != nil {
+			return err
+		}
+		*v = value
+		return nil
+	}
+}
+
+func serializeDurationInternal(v time.Duration) string {
+	if v == 0 {
+		return "PT0S"
+	}
+	result := "PT"
+	// The magnitude is unsigned, negating math.MinInt64 would overflow.
+	magnitude := uint64(v)
+	if v < 0 {
+		result = "-PT"
+		magnitude = -magnitude
+	}
+	if hours := magnitude / uint64(time.Hour); hours > 0 {
+		result += strconv.FormatUint(hours, 10) + "H"
+		magnitude %= uint64(time.Hour)
+	}
+	if minutes := magnitude / uint64(time.Minute); minutes > 0 {
+		result += strconv.FormatUint(minutes, 10) + "M"
+		magnitude %= uint64(time.Minute)
+	}
+	if magnitude > 0 {
+		result += strconv.FormatUint(magnitude/uint64(time.Second), 10)
+		if nanoseconds := magnitude % uint64(time.Second); nanoseconds > 0 {
+			result += "." + strings.TrimRight(strconv.FormatUint(nanoseconds+uint64(time.Second), 10)[1:], "0")
+		}
+		result += "S"
+	}
+	return result
+}
+
+func parseDurationComponent(number string, unit time.Duration) (time.Duration, error) {
+	whole, fraction, _ := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+	wholeValue, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if wholeValue > math.MaxInt64/int64(unit) {
+		return 0, fmt.Errorf("duration component %s overflows", number)
+	}
+	var fractionValue int64
+	if fraction != "" {
+		// The fraction is kept to nanosecond precision of the unit.
+		fraction = (fraction + "000000000")[:9]
+		if fractionValue, err = strconv.ParseInt(fraction, 10, 64); err != nil {
+			return 0, err
+		}
+	}
+	wholePart := time.Duration(wholeValue) * unit
+	fractionPart := time.Duration(fractionValue) * (unit / time.Second)
+	if wholePart > math.MaxInt64-fractionPart {
+		return 0, fmt.Errorf("duration component %s overflows", number)
+	}
+	return wholePart + fractionPart, nil
+}
+
+func parseDurationISO8601(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid ISO 8601 duration %q", s)
+	value, negative := strings.CutPrefix(s, "-")
+	value, found := strings.CutPrefix(value, "P")
+	if !found || value == "" || strings.HasSuffix(value, "T") {
+		return 0, invalid
+	}
+
+	// The magnitude is unsigned, the one of math.MinInt64 doesn't fit in a time.Duration.
+	var magnitude uint64
+	maxMagnitude := uint64(math.MaxInt64)
+	if negative {
+		maxMagnitude++
+	}
+	inTime := false
+	lastUnit := time.Duration(math.MaxInt64)
+	for value != "" {
+		if value[0] == 'T' {
+			if inTime {
+				return 0, invalid
+			}
+			inTime = true
+			value = value[1:]
+			continue
+		}
+		end := strings.IndexFunc(value, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, invalid
+		}
+		var unit time.Duration
+		switch designator := value[end]; {
+		case !inTime && designator == 'W':
+			unit = 7 * 24 * time.Hour
+		case !inTime && designator == 'D':
+			unit = 24 * time.Hour
+		case inTime && designator == 'H':
+			unit = time.Hour
+		case inTime && designator == 'M':
+			unit = time.Minute
+		case inTime && designator == 'S':
+			unit = time.Second
+		default:
+			// Years and months don't have a fixed length and can't be represented as a time.Duration.
+			return 0, invalid
+		}
+		if unit >= lastUnit {
+			return 0, invalid
+		}
+		component, err := parseDurationComponent(value[:end], unit)
+		if err != nil {
+			return 0, invalid
+		}
+		// Both are at most math.MaxInt64 + 1, their sum can't wrap around.
+		if magnitude += uint64(component); magnitude > maxMagnitude {
+			return 0, fmt.Errorf("ISO 8601 duration %q overflows", s)
+		}
+		lastUnit = unit
+		value = value[end+1:]
+	}
+	if negative {
+		return time.Duration(-magnitude), nil
+	}
+	return time.Duration(magnitude), nil
+}
+
 func unmarshalDurationInternal(data []byte, duration *time.Duration) error {
 	var durationString string
 	if err := json.Unmarshal(data, &durationString); err != nil {
 		return err
 	}
 
-	var v time.Duration
-	var err error
-	if v, err = time.ParseDuration(durationString); err != nil {
+	v, err := parseDurationISO8601(durationString)
+	if err != nil {
 		return err
 	}
 	*duration = v
 
 	return nil
 }
+
+func serializeDurationSecondsInternal(v time.Duration) int64 {
+	return int64(v / time.Second)
+}
+
+func unmarshalDurationSecondsInternal(data []byte, duration *time.Duration) error {
+	var seconds int64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	*duration = time.Duration(seconds) * time.Second
+
+	return nil
+}
+
+func serializeDurationSecondsFloatInternal(v time.Duration) float64 {
+	return v.Seconds()
+}
+
+func unmarshalDurationSecondsFloatInternal(data []byte, duration *time.Duration) error {
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	*duration = time.Duration(math.Round(seconds * float64(time.Second)))
+
+	return nil
+}
+
+func serializeDurationMillisecondsInternal(v time.Duration) int64 {
+	return v.Milliseconds()
+}
+
+func unmarshalDurationMillisecondsInternal(data []byte, duration *time.Duration) error {
+	var milliseconds int64
+	if err := json.Unmarshal(data, &milliseconds); err != nil {
+		return err
+	}
+	*duration = time.Duration(milliseconds) * time.Millisecond
+
+	return nil
+}
+
+func serializeDurationMillisecondsFloatInternal(v time.Duration) float64 {
+	return float64(v) / float64(time.Millisecond)
+}
+
+func unmarshalDurationMillisecondsFloatInternal(data []byte, duration *time.Duration) error {
+	var milliseconds float64
+	if err := json.Unmarshal(data, &milliseconds); err != nil {
+		return err
+	}
+	*duration = time.Duration(math.Round(milliseconds * float64(time.Millisecond)))
+
+	return nil
+}
+
+func serializeUtcDateTimeInternal(v time.Time) string {
+	return v.UTC().Format(time.RFC3339Nano)
+}
+
+func unmarshalUtcDateTimeInternal(data []byte, dateTime *time.Time) error {
+	var v time.Time
+	if err := unmarshalOffsetDateTimeInternal(data, &v); err != nil {
+		return err
+	}
+	*dateTime = v.UTC()
+
+	return nil
+}
+
+func serializeOffsetDateTimeInternal(v time.Time) string {
+	return v.Format(time.RFC3339Nano)
+}
+
+func unmarshalOffsetDateTimeInternal(data []byte, dateTime *time.Time) error {
+	var dateTimeString string
+	if err := json.Unmarshal(data, &dateTimeString); err != nil {
+		return err
+	}
+
+	v, err := time.Parse(time.RFC3339Nano, dateTimeString)
+	if err != nil {
+		return err
+	}
+	*dateTime = v
+
+	return nil
+}
+
+const rfc7231Layout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+func serializeDateTimeRFC7231Internal(v time.Time) string {
+	return v.UTC().Format(rfc7231Layout)
+}
+
+func unmarshalDateTimeRFC7231Internal(data []byte, dateTime *time.Time) error {
+	var dateTimeString string
+	if err := json.Unmarshal(data, &dateTimeString); err != nil {
+		return err
+	}
+
+	v, err := time.Parse(rfc7231Layout, dateTimeString)
+	if err != nil {
+		return err
+	}
+	*dateTime = v
+
+	return nil
+}
+
+func serializeDateTimeUnixTimestampInternal(v time.Time) int64 {
+	return v.Unix()
+}
+
+func unmarshalDateTimeUnixTimestampInternal(data []byte, dateTime *time.Time) error {
+	var timestamp int64
+	if err := json.Unmarshal(data, &timestamp); err != nil {
+		return err
+	}
+	*dateTime = time.Unix(timestamp, 0).UTC()
+
+	return nil
+}
+
+// unixTimestamp32 is a unix timestamp encoded as an int32, encoding fails for times out of its range.
+type unixTimestamp32 int64
+
+func (t unixTimestamp32) MarshalJSON() ([]byte, error) {
+	if t < math.MinInt32 || t > math.MaxInt32 {
+		return nil, fmt.Errorf("unix timestamp %d overflows int32", int64(t))
+	}
+	return json.Marshal(int32(t))
+}
+
+func serializeDateTimeUnixTimestampInt32Internal(v time.Time) unixTimestamp32 {
+	return unixTimestamp32(v.Unix())
+}
+
+func unmarshalDateTimeUnixTimestampInt32Internal(data []byte, dateTime *time.Time) error {
+	var timestamp int32
+	if err := json.Unmarshal(data, &timestamp); err != nil {
+		return err
+	}
+	*dateTime = time.Unix(int64(timestamp), 0).UTC()
+
+	return nil
+}
+
+func serializeBytesBase64Internal(v []byte) string {
+	return base64.StdEncoding.EncodeToString(v)
+}
+
+func unmarshalBytesBase64Internal(data []byte, bytes *[]byte) error {
+	var bytesString string
+	if err := json.Unmarshal(data, &bytesString); err != nil {
+		return err
+	}
+
+	v, err := base64.StdEncoding.DecodeString(bytesString)
+	if err != nil {
+		return err
+	}
+	*bytes = v
+
+	return nil
+}
+
+func serializeBytesBase64URLInternal(v []byte) string {
+	return base64.RawURLEncoding.EncodeToString(v)
+}
+
+func unmarshalBytesBase64URLInternal(data []byte, bytes *[]byte) error {
+	var bytesString string
+	if err := json.Unmarshal(data, &bytesString); err != nil {
+		return err
+	}
+
+	encoding := base64.RawURLEncoding
+	if len(bytesString) > 0 && bytesString[len(bytesString)-1] == '=' {
+		encoding = base64.URLEncoding
+	}
+	v, err := encoding.DecodeString(bytesString)
+	if err != nil {
+		return err
+	}
+	*bytes = v
+
+	return nil
+}
+
+func serializeURLInternal(v url.URL) string {
+	return v.String()
+}
+
+func unmarshalURLInternal(data []byte, u *url.URL) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	v, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if !v.IsAbs() {
+		return fmt.Errorf("invalid url %q: missing scheme", s)
+	}
+	*u = *v
+
+	return nil
+}
+
+func serializeIntStringInternal[T int8 | int16 | int32 | int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func unmarshalIntStringInternal[T int8 | int16 | int32 | int64](data []byte, v *T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	// Values out of range for T don't survive the conversion.
+	if err != nil || int64(T(n)) != n {
+		return fmt.Errorf("invalid %T %q", *v, s)
+	}
+	*v = T(n)
+
+	return nil
+}
+
+func serializeUintStringInternal[T uint8 | uint16 | uint32 | uint64](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+func unmarshalUintStringInternal[T uint8 | uint16 | uint32 | uint64](data []byte, v *T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || uint64(T(n)) != n {
+		return fmt.Errorf("invalid %T %q", *v, s)
+	}
+	*v = T(n)
+
+	return nil
+}
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+func keyPath(path string, key string) string {
+	return fmt.Sprintf("%s[%q]", path, key)
+}
+
+// MissingPropertiesError is returned when decoding JSON lacking required properties of a model.
+type MissingPropertiesError struct {
+	Model      string
+	Properties []string
+}
+
+func (e *MissingPropertiesError) Error() string {
+	return fmt.Sprintf("missing required properties of %s: %s", e.Model, strings.Join(e.Properties, ", "))
+}
+
+// UnknownPropertiesError is returned when decoding JSON with properties unknown to a model in strict mode.
+type UnknownPropertiesError struct {
+	Model string
+	Paths []string
+}
+
+func (e *UnknownPropertiesError) Error() string {
+	return fmt.Sprintf("unknown properties in %s: %s", e.Model, strings.Join(e.Paths, ", "))
+}
+
+type unknownCollector interface {
+	collectUnknown(data []byte, path string, unknown *[]string)
+}
+
+// collectUnknownValue collects the unknown properties of values whose type is only known at run time.
+func collectUnknownValue(data []byte, path string, unknown *[]string, value interface{}) {
+	if v, ok := value.(unknownCollector); ok {
+		v.collectUnknown(data, path, unknown)
+	}
+}
+
+// findUnknownProperties returns the sorted paths of the properties of data unknown to value.
+func findUnknownProperties(data []byte, value unknownCollector) []string {
+	var unknown []string
+	value.collectUnknown(data, "", &unknown)
+	sort.Strings(unknown)
+	return unknown
+}
+
+// rawEntries returns the properties of the JSON object data, nil when it isn't an object.
+func rawEntries(data []byte) map[string]json.RawMessage {
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil
+	}
+	return entries
+}
+
+// rawItems returns the items of the JSON array data, nil when it isn't an array.
+func rawItems(data []byte) []json.RawMessage {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil
+	}
+	return items
+}
+
+// ValidationError lists the constraints violated by a model and the values it contains.
+type ValidationError struct {
+	Violations []Violation
+}
+
+// Violation is a constraint violated by the value at Path, a JSON path like items[0].name.
+type Violation struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Path + ": " + v.Message
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+type violations []Violation
+
+func (e *violations) addf(path string, format string, args ...interface{}) {
+	*e = append(*e, Violation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (e violations) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: e}
+}
+
+type validatable interface {
+	validate(errs *violations, path string)
+}
+
+// validateValue validates values whose type is only known at run time, like union variants.
+func validateValue(errs *violations, path string, value interface{}) {
+	if v, ok := value.(validatable); ok {
+		v.validate(errs, path)
+	}
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validFormat checks s against the known values of @format, unknown formats are accepted.
+func validFormat(format string, s string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	case "date":
+		_, err := time.Parse("2006-01-02", s)
+		return err == nil
+	case "email":
+		_, err := mail.ParseAddress(s)
+		return err == nil
+	case "uri", "url":
+		u, err := url.Parse(s)
+		return err == nil && u.IsAbs()
+	case "uuid":
+		return uuidPattern.MatchString(s)
+	case "ipv4":
+		ip := net.ParseIP(s)
+		return ip != nil && ip.To4() != nil
+	case "ipv6":
+		ip := net.ParseIP(s)
+		return ip != nil && ip.To4() == nil
+	default:
+		return true
+	}
+}
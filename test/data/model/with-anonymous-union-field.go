@@ -20,7 +20,7 @@ func (f *GlassMaterial) UnmarshalJSON(data []byte) error {
 }
 
 func (f GlassMaterial) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
 
 type Glass struct {
@@ -46,5 +46,5 @@ func (f *UserInterfaceLanguages) UnmarshalJSON(data []byte) error {
 }
 
 func (f UserInterfaceLanguages) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
@@ -13,7 +13,7 @@ func (m *Room) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	if v, ok := rawMsg["seating"]; ok {
-		if err := json.Unmarshal(v, &m.Seating); err != nil {
+		if err := unmarshalSlice(v, &m.Seating, unmarshalWith(UnmarshalSeating)); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,25 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRoomDeserialization(t *testing.T) {
+	data := []byte(`{"seating":[{"type":"chair","legs":4},{"type":"bench","length":2}]}`)
+
+	var room Room
+	if err := json.Unmarshal(data, &room); err != nil {
+		t.Fatalf("Failed to unmarshal Room: %v", err)
+	}
+
+	if len(room.Seating) != 2 {
+		t.Fatalf("Expected 2 seats but got %d", len(room.Seating))
+	}
+	if chair, ok := room.Seating[0].(Chair); !ok || chair.Legs != 4 {
+		t.Errorf("Expected a chair with 4 legs but got %v", room.Seating[0])
+	}
+	if bench, ok := room.Seating[1].(Bench); !ok || bench.Length != 2 {
+		t.Errorf("Expected a bench of length 2 but got %v", room.Seating[1])
+	}
+}
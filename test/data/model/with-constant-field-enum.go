@@ -42,5 +42,5 @@ func (f *Bar) UnmarshalJSON(data []byte) error {
 }
 
 func (f Bar) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
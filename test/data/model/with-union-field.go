@@ -20,7 +20,7 @@ func (f *Material) UnmarshalJSON(data []byte) error {
 }
 
 func (f Material) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
 
 type Cup struct {
@@ -22,5 +22,5 @@ func (f *NumberNoScalar) UnmarshalJSON(data []byte) error {
 
 func (f NumberNoScalar) MarshalJSON() ([]byte, error) {
 
-	return json.Marshal(f)
+	return json.Marshal(int64(f))
 }
@@ -22,5 +22,5 @@ func (f *NumberScalar) UnmarshalJSON(data []byte) error {
 
 func (f NumberScalar) MarshalJSON() ([]byte, error) {
 
-	return json.Marshal(f)
+	return json.Marshal(int32(f))
 }
@@ -22,5 +22,5 @@ func (f *StringNoScalar) UnmarshalJSON(data []byte) error {
 
 func (f StringNoScalar) MarshalJSON() ([]byte, error) {
 
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
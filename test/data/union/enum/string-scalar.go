@@ -22,5 +22,5 @@ func (f *StringScalar) UnmarshalJSON(data []byte) error {
 
 func (f StringScalar) MarshalJSON() ([]byte, error) {
 
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
@@ -53,7 +53,7 @@ func (f *MetalStringValues) UnmarshalJSON(data []byte) error {
 }
 
 func (f MetalStringValues) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
 
 type Metal interface {
@@ -53,7 +53,7 @@ func (f *LocaleStringValues) UnmarshalJSON(data []byte) error {
 }
 
 func (f LocaleStringValues) MarshalJSON() ([]byte, error) {
-	return json.Marshal(f)
+	return json.Marshal(string(f))
 }
 
 type Locale interface {
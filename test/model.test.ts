@@ -1,6 +1,7 @@
+import { expectDiagnostics } from "@typespec/compiler/testing";
 import { describe, expect, it } from "vitest";
-import { baseGetTestData, normalizeCode, scopeGetTestData } from "./common.js";
-import { emit } from "./test-host.js";
+import { baseGetExpectedOutput, baseGetTestData, normalizeCode, scopeGetTestData } from "./common.js";
+import { emit, emitWithDiagnostics } from "./test-host.js";
 
 describe("model generation", () => {
   let getTestData = scopeGetTestData("model", baseGetTestData);
@@ -17,6 +18,36 @@ describe("model generation", () => {
     expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
   });
 
+  it("handles models with date time fields", async () => {
+    const [input, expected] = await getTestData("datetime");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with plain date and time fields", async () => {
+    const [input, expected] = await getTestData("plain-date-time");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with bytes fields", async () => {
+    const [input, expected] = await getTestData("bytes");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with decimal fields", async () => {
+    const [input, expected] = await getTestData("decimal");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with encoded duration fields", async () => {
+    const [input, expected] = await getTestData("duration-encoding");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
   it("handles models with references to other models", async () => {
     const [input, expected] = await getTestData("references");
     const results = await emit(input);
@@ -83,6 +114,268 @@ describe("model generation", () => {
     expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
   });
 
+  it("handles models with record fields", async () => {
+    const [input, expected] = await getTestData("record");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with enum fields", async () => {
+    const [input, expected] = await getTestData("enum");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with user-defined scalar fields", async () => {
+    const [input, expected] = await getTestData("scalars");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with unknown fields", async () => {
+    const [input, expected] = await getTestData("unknown");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with unknown fields emitted as any", async () => {
+    const [input, expected] = await getTestData("unknown-any");
+    const results = await emit(input, { "unknown-type": "any" });
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with tuple fields", async () => {
+    const [input, expected] = await getTestData("tuple");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles model templates as generic types", async () => {
+    const [input, expected] = await getTestData("generics");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with string encoded integer fields", async () => {
+    const [input, expected] = await getTestData("string-encoded-integers");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with url fields", async () => {
+    const [input, expected] = await getTestData("url");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles recursive and mutually recursive models", async () => {
+    const [input, expected] = await getTestData("recursive");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("breaks cycles closing through a parent, a tuple or a generic instance", async () => {
+    const [input, expected] = await getTestData("recursive-inheritance");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models composed with spread, is and intersections", async () => {
+    const [input, expected] = await getTestData("composition");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with additional properties", async () => {
+    const [input, expected] = await getTestData("additional-properties");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles models with nullable elements and nullable collections", async () => {
+    const [input, expected] = await getTestData("nullable-collections");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("names anonymous types after their position", async () => {
+    const [input, expected] = await getTestData("anonymous-types");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("reports anonymous types named like a declared type", async () => {
+    const [_, diagnostics] = await emitWithDiagnostics(`
+      namespace modeltest;
+
+      model Itinerary {
+        stops: {
+          name: string;
+        }[];
+      }
+
+      model ItineraryStopsItem {
+        id: string;
+      }
+    `);
+    expectDiagnostics(diagnostics, {
+      code: "go-emitter/duplicate-type-name",
+      message: "Anonymous type ItineraryStopsItem is named like another type of its package, rename one of them.",
+    });
+  });
+
+  it("names types after aliases and @friendlyName", async () => {
+    const [input, expected] = await getTestData("naming");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles aliases of named types emitted as Go type aliases", async () => {
+    const [input, expected] = await getTestData("type-aliases");
+    const results = await emit(input, { "type-aliases": true });
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles constraint decorators", async () => {
+    const [input, expected] = await getTestData("constraints");
+    const expectedValidation = await baseGetExpectedOutput("model/constraints-validation");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+    expect(normalizeCode(results["modeltest/validation.go"])).toBe(normalizeCode(expectedValidation));
+  });
+
+  it("enforces required properties", async () => {
+    const [input, expected] = await getTestData("required-properties");
+    const results = await emit(input, { "required-properties": "enforce" });
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("handles unknown properties per model", async () => {
+    const [input, expected] = await getTestData("unknown-properties");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("preserves unknown properties", async () => {
+    const [input, expected] = await getTestData("preserve-unknown-properties");
+    const results = await emit(input, { "preserve-unknown-properties": true });
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("emits property defaults", async () => {
+    const [input, expected] = await getTestData("defaults");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
+  it("reports properties emitted under the same name", async () => {
+    const [_, diagnostics] = await emitWithDiagnostics(`
+      namespace modeltest;
+
+      model Account {
+        user_name: string;
+      }
+
+      model User {
+        ...Account;
+        userName: string;
+      }
+    `);
+    expectDiagnostics(diagnostics, {
+      code: "go-emitter/duplicate-property",
+      message: "Properties user_name and userName of User are both emitted as UserName.",
+    });
+  });
+
+  it("only emits the helpers used by the package", async () => {
+    const results = await emit(`
+      namespace modeltest;
+
+      model Decimal {
+        value: string;
+      }
+    `);
+    expect(results["modeltest/utils.go"]).not.toContain("type Decimal struct");
+    expect(results["modeltest/validation.go"]).toBeDefined();
+  });
+
+  it("only emits the validation helpers when a type is validated", async () => {
+    const results = await emit(`
+      namespace modeltest;
+
+      enum Color {
+        red,
+      }
+    `);
+    expect(results["modeltest/utils.go"]).not.toContain("type ValidationError struct");
+    expect(results["modeltest/validation.go"]).toBeUndefined();
+  });
+
+  it("reports types named like the helpers they use", async () => {
+    const [_, diagnostics] = await emitWithDiagnostics(`
+      namespace modeltest;
+
+      model Date {
+        day: plainDate;
+      }
+    `);
+    expectDiagnostics(diagnostics, {
+      code: "go-emitter/reserved-name",
+      message: "Date is emitted as Date, which is declared by the helpers generated in its package.",
+    });
+  });
+
+  it("reports defaults which can't be expressed in Go", async () => {
+    const [_, diagnostics] = await emitWithDiagnostics(`
+      namespace modeltest;
+
+      model Playlist {
+        tags?: string[] = #["favorites"];
+      }
+    `);
+    expectDiagnostics(diagnostics, {
+      code: "go-emitter/unsupported-default",
+      message: "Default value of property tags of Playlist can't be expressed in Go, it is ignored.",
+      severity: "warning",
+    });
+  });
+
+  it("reports ISO defaults Go can't parse", async () => {
+    const [_, diagnostics] = await emitWithDiagnostics(`
+      namespace modeltest;
+
+      model Event {
+        day?: plainDate = plainDate.fromISO("2024-02-30");
+      }
+    `);
+    expectDiagnostics(diagnostics, {
+      code: "go-emitter/unsupported-default",
+      message: "Default value of property day of Event can't be expressed in Go, it is ignored.",
+      severity: "warning",
+    });
+  });
+
+  it("reports relative url defaults", async () => {
+    const [_, diagnostics] = await emitWithDiagnostics(`
+      namespace modeltest;
+
+      model Product {
+        manual?: url = "/docs/manual";
+      }
+    `);
+    expectDiagnostics(diagnostics, {
+      code: "go-emitter/unsupported-default",
+      message: "Default value of property manual of Product can't be expressed in Go, it is ignored.",
+      severity: "warning",
+    });
+  });
+
+  it("handles models with nested template instance fields", async () => {
+    const [input, expected] = await getTestData("nested-templates");
+    const results = await emit(input);
+    expect(normalizeCode(results["modeltest/models.go"])).toBe(normalizeCode(expected));
+  });
+
   it("handles models that inherit from other models", async () => {
     const [input, expected] = await getTestData("inheritance");
     const results = await emit(input);
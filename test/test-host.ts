@@ -1,4 +1,5 @@
 import { CompilerHost, Diagnostic, resolvePath } from "@typespec/compiler";
+import { GoEmitterOptions } from "../src/lib.js";
 import { createTestHost, createTestWrapper, expectDiagnosticEmpty } from "@typespec/compiler/testing";
 import { GoEmitterTestLibrary } from "../src/testing/index.js";
 
@@ -36,10 +37,16 @@ async function readDir(host: CompilerHost, directory: string): Promise<Record<st
   return result;
 }
 
-export async function emitWithDiagnostics(code: string): Promise<[Record<string, string>, readonly Diagnostic[]]> {
+export async function emitWithDiagnostics(
+  code: string,
+  options: GoEmitterOptions = {},
+): Promise<[Record<string, string>, readonly Diagnostic[]]> {
   const runner = await createGoEmitterTestRunner();
   await runner.compileAndDiagnose(code, {
     outputDir: "tsp-output",
+    options: {
+      "go-emitter": { ...options },
+    },
   });
   const emitterOutputDir = "./tsp-output/go-emitter";
 
@@ -58,8 +65,8 @@ export async function emitWithDiagnostics(code: string): Promise<[Record<string,
   return [result, runner.program.diagnostics];
 }
 
-export async function emit(code: string): Promise<Record<string, string>> {
-  const [result, diagnostics] = await emitWithDiagnostics(code);
+export async function emit(code: string, options: GoEmitterOptions = {}): Promise<Record<string, string>> {
+  const [result, diagnostics] = await emitWithDiagnostics(code, options);
   expectDiagnosticEmpty(diagnostics);
   return result;
 }